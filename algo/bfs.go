@@ -39,8 +39,8 @@ and the path itself (Parent pointer).
 Slightly different trees can be generated for the same graph and source, if the visiting order for
 either vertices or edges is changed, but the optimal distances are guaranteed to remain the same.
 
-The gga graph implementation guarantees both vertex and edge traversal in insertion order,
-so repeated BFS calls always produce the same BF tree.
+Every ds.Graph implementation guarantees that vertices and edges are always traversed in the same order,
+so repeated BFS calls on the same graph always produce the same BF tree.
 */
 type BFTree []BFNode
 
@@ -59,11 +59,11 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func BFS(g *ds.G, src int) (BFTree, error) {
+func BFS(g ds.Graph, src int) (BFTree, error) {
 	tree := make(BFTree, g.VertexCount())
 	queue := ds.NewQueue[int]()
 
-	for v := range g.V {
+	for v := range tree {
 		tree[v].Distance = math.Inf(1)
 		tree[v].Parent = -1
 	}
@@ -76,9 +76,9 @@ func BFS(g *ds.G, src int) (BFTree, error) {
 	for !queue.Empty() {
 		curr, _ := queue.Dequeue()
 
-		for _, edge := range g.V[curr].E {
+		g.ForEachEdge(curr, func(edge ds.GE) {
 			if tree[edge.Dst].visited {
-				continue
+				return
 			}
 
 			tree[edge.Dst].Distance = tree[curr].Distance + 1
@@ -86,7 +86,7 @@ func BFS(g *ds.G, src int) (BFTree, error) {
 			tree[edge.Dst].visited = true
 
 			queue.Enqueue(edge.Dst)
-		}
+		})
 	}
 
 	return tree, nil
@@ -0,0 +1,30 @@
+package algo
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+)
+
+func BenchmarkBFS(b *testing.B) {
+	for _, size := range []int{16, 256, 1024} {
+		b.Run(fmt.Sprintf("adj-list-%d", size), func(b *testing.B) {
+			g := sccBenchGen(size)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				BFS(g, 0)
+			}
+		})
+
+		b.Run(fmt.Sprintf("csr-%d", size), func(b *testing.B) {
+			g := ds.NewCSR(sccBenchGen(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				BFS(g, 0)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ CCAlgo describes the signature of an algorithm that can discover all
 connected components in an undirected graph. If such an algorithm
 is called on a directed graph, the ds.ErrUndefOp error is returned.
 */
-type CCAlgo func(*ds.G) ([]CC, error)
+type CCAlgo func(ds.Graph) ([]CC, error)
 
 // A CC holds the vertices in a connected component of an undirected graph.
 type CC []int
@@ -34,7 +34,7 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func CCDFS(g *ds.G) ([]CC, error) {
+func CCDFS(g ds.Graph) ([]CC, error) {
 	if g.Directed() {
 		return nil, ds.ErrDirected
 	}
@@ -48,16 +48,16 @@ func CCDFS(g *ds.G) ([]CC, error) {
 	visit = func(v int) {
 		visited[v] = true
 
-		for _, e := range g.V[v].E {
+		g.ForEachEdge(v, func(e ds.GE) {
 			if !visited[e.Dst] {
 				visit(e.Dst)
 			}
-		}
+		})
 
 		*cc = append(*cc, v)
 	}
 
-	for v := range g.V {
+	for v := range visited {
 		if visited[v] {
 			continue
 		}
@@ -95,7 +95,7 @@ Complexity:
 	- Time:  O((V + E) α(V)), amortized
 	- Space: Θ(V)
 */
-func CCUnionFind(g *ds.G) ([]CC, error) {
+func CCUnionFind(g ds.Graph) ([]CC, error) {
 	if g.Directed() {
 		return nil, ds.ErrDirected
 	}
@@ -103,19 +103,19 @@ func CCUnionFind(g *ds.G) ([]CC, error) {
 	sets := map[int]CC{}
 	d := ds.NewDSet[int]()
 
-	for v := range g.V {
+	for v := 0; v < g.VertexCount(); v++ {
 		d.MakeSet(v)
 	}
 
-	for v := range g.V {
-		for _, e := range g.V[v].E {
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e ds.GE) {
 			if d.FindSet(e.Src) != d.FindSet(e.Dst) {
 				d.Union(e.Src, e.Dst)
 			}
-		}
+		})
 	}
 
-	for v := range g.V {
+	for v := 0; v < g.VertexCount(); v++ {
 		set := d.FindSet(v)
 		sets[set] = append(sets[set], v)
 	}
@@ -128,7 +128,7 @@ func CCUnionFind(g *ds.G) ([]CC, error) {
 	// If such consistency is not necessary, we
 	// could just iterate over the map instead.
 	// Asymptotically, it's all O(V) anyway.
-	for v := range g.V {
+	for v := 0; v < g.VertexCount(); v++ {
 		cc, ok := sets[v]
 
 		if !ok {
@@ -5,30 +5,11 @@ import (
 	"testing"
 
 	"github.com/vc-souza/gga/ds"
-	ut "github.com/vc-souza/gga/internal/testutils"
+	"github.com/vc-souza/gga/gen"
 )
 
 func ccBenchGen(n int) *ds.G {
-	g := ds.NewGraph()
-
-	for i := 0; i < n; i++ {
-		v := ut.BenchItem(i)
-		g.AddVertex(&v)
-	}
-
-	for i := 0; i < n; i++ {
-		for j := 0; j < n; j++ {
-			if i == j {
-				continue
-			}
-
-			g.AddEdge(
-				g.V[i].Item,
-				g.V[j].Item,
-				0,
-			)
-		}
-	}
+	g, _ := gen.Complete(n)
 
 	return g
 }
@@ -52,5 +33,23 @@ func BenchmarkCC(b *testing.B) {
 				CCUnionFind(g)
 			}
 		})
+
+		b.Run(fmt.Sprintf("dfs-csr-%d", size), func(b *testing.B) {
+			g := ds.NewCSR(ccBenchGen(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				CCDFS(g)
+			}
+		})
+
+		b.Run(fmt.Sprintf("union-find-csr-%d", size), func(b *testing.B) {
+			g := ds.NewCSR(ccBenchGen(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				CCUnionFind(g)
+			}
+		})
 	}
 }
@@ -36,8 +36,8 @@ of the DF tree, during the execution of the DFS.
 Slightly different trees can be generated for the same graph, if the visiting order for either vertices
 or edges is changed.
 
-The gga graph implementation guarantees both vertex and edge traversal in insertion order,
-so repeated DFS calls always produce the same DF forest.
+Every ds.Graph implementation guarantees that vertices and edges are always traversed in the same order,
+so repeated DFS calls on the same graph always produce the same DF forest.
 */
 type DFForest []DFNode
 
@@ -105,14 +105,14 @@ Complexity:
 	- Space (without edge classification): Θ(V)
 	- Space (wit edge classification): Θ(V) + O(E)
 */
-func DFS(g *ds.G, classify bool) (DFForest, EdgeTypes, error) {
+func DFS(g ds.Graph, classify bool) (DFForest, EdgeTypes, error) {
 	var visit func(int)
 
 	fst := make(DFForest, g.VertexCount())
 	tps := EdgeTypes{}
 	t := 0
 
-	for v := range g.V {
+	for v := range fst {
 		fst[v].Parent = -1
 	}
 
@@ -122,10 +122,10 @@ func DFS(g *ds.G, classify bool) (DFForest, EdgeTypes, error) {
 		fst[v].Discovery = t
 		fst[v].visited = true
 
-		for _, e := range g.V[v].E {
+		g.ForEachEdge(v, func(e ds.GE) {
 			if fst[e.Dst].visited {
 				if !classify {
-					continue
+					return
 				}
 
 				if g.Directed() {
@@ -137,14 +137,14 @@ func DFS(g *ds.G, classify bool) (DFForest, EdgeTypes, error) {
 				fst[e.Dst].Parent = v
 				visit(e.Dst)
 			}
-		}
+		})
 
 		t++
 
 		fst[v].Finish = t
 	}
 
-	for v := range g.V {
+	for v := range fst {
 		if !fst[v].visited {
 			visit(v)
 		}
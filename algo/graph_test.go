@@ -0,0 +1,457 @@
+package algo
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// gridCell identifies a cell in a grid.
+type gridCell struct{ row, col int }
+
+func (c gridCell) Label() string {
+	return ""
+}
+
+/*
+gridGraph is an implicit ds.Graph, where every cell of a grid is a vertex,
+connected to the cells above, below, to its left and to its right.
+Nothing is stored, with every edge being calculated on demand.
+*/
+type gridGraph struct{ rows, cols int }
+
+func (g gridGraph) Directed() bool   { return false }
+func (g gridGraph) Undirected() bool { return true }
+func (g gridGraph) VertexCount() int { return g.rows * g.cols }
+
+func (g gridGraph) EdgeCount() int {
+	return g.rows*(g.cols-1) + g.cols*(g.rows-1)
+}
+
+func (g gridGraph) VertexIndex(i ds.Item) (int, bool) {
+	c, ok := i.(gridCell)
+
+	if !ok || c.row < 0 || c.row >= g.rows || c.col < 0 || c.col >= g.cols {
+		return 0, false
+	}
+
+	return c.row*g.cols + c.col, true
+}
+
+func (g gridGraph) Item(v int) ds.Item {
+	return gridCell{v / g.cols, v % g.cols}
+}
+
+func (g gridGraph) ForEachEdge(v int, fn func(ds.GE)) {
+	c := g.Item(v).(gridCell)
+
+	moves := []gridCell{
+		{c.row - 1, c.col},
+		{c.row, c.col - 1},
+		{c.row, c.col + 1},
+		{c.row + 1, c.col},
+	}
+
+	for i, m := range moves {
+		if u, ok := g.VertexIndex(m); ok {
+			fn(ds.GE{Src: v, Dst: u, Wt: 1, Index: i})
+		}
+	}
+}
+
+// toMatrix copies a graph into a graph backed by an adjacency matrix.
+func toMatrix(g *ds.G) *ds.M {
+	var m *ds.M
+
+	if g.Directed() {
+		m = ds.NewMatrixDigraph()
+	} else {
+		m = ds.NewMatrixGraph()
+	}
+
+	for v := range g.V {
+		m.AddVertex(g.V[v].Item)
+	}
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			m.AddEdge(g.V[e.Src].Item, g.V[e.Dst].Item, e.Wt)
+		}
+	}
+
+	return m
+}
+
+// doubled copies a graph into a multigraph where every edge is added twice, as a pair of parallel edges.
+func doubled(t *testing.T, g *ds.G) *ds.G {
+	var res *ds.G
+
+	if g.Directed() {
+		res = ds.NewDigraphWith(ds.Multigraph())
+	} else {
+		res = ds.NewGraphWith(ds.Multigraph())
+	}
+
+	for v := range g.V {
+		_, err := res.AddVertex(g.V[v].Item)
+		ut.Nil(t, err)
+	}
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			// undirected edges are added once, through one of their copies
+			if g.Undirected() && e.Src > e.Dst {
+				continue
+			}
+
+			for i := 0; i < 2; i++ {
+				_, _, err := res.AddEdge(g.V[e.Src].Item, g.V[e.Dst].Item, e.Wt)
+				ut.Nil(t, err)
+			}
+		}
+	}
+
+	return res
+}
+
+// sortedSets sorts every set of vertices, and then the sets themselves, so they can be compared.
+func sortedSets[T ~[]int](sets []T) [][]int {
+	res := make([][]int, len(sets))
+
+	for i := range sets {
+		res[i] = append([]int{}, sets[i]...)
+		sort.Ints(res[i])
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i][0] < res[j][0]
+	})
+
+	return res
+}
+
+func TestGraph_matrix_directed(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(g)
+
+	for v := range g.V {
+		treeG, err := BFS(g, v)
+		ut.Nil(t, err)
+
+		treeM, err := BFS(m, v)
+		ut.Nil(t, err)
+
+		for u := range treeG {
+			ut.Equal(t, treeG[u].Distance, treeM[u].Distance)
+		}
+	}
+
+	for _, algo := range []SCCAlgo{SCCKosaraju, SCCTarjan} {
+		sccsG, err := algo(g)
+		ut.Nil(t, err)
+
+		sccsM, err := algo(m)
+		ut.Nil(t, err)
+
+		setsG, setsM := sortedSets(sccsG), sortedSets(sccsM)
+
+		ut.Equal(t, len(setsG), len(setsM))
+
+		for i := range setsG {
+			ut.Equal(t, len(setsG[i]), len(setsM[i]))
+
+			for j := range setsG[i] {
+				ut.Equal(t, setsG[i][j], setsM[i][j])
+			}
+		}
+	}
+}
+
+func TestGraph_matrix_dag(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDress)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(g)
+
+	ord, err := TSort(m)
+
+	ut.Nil(t, err)
+
+	pos := make([]int, len(ord))
+
+	for i, v := range ord {
+		pos[v] = i
+	}
+
+	for v := range m.V {
+		m.ForEachEdge(v, func(e ds.GE) {
+			ut.True(t, pos[e.Src] < pos[e.Dst])
+		})
+	}
+}
+
+func TestGraph_matrix_undirected(t *testing.T) {
+	g, _, err := ds.Parse(ut.WUGSimple)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(g)
+
+	for _, algo := range []CCAlgo{CCDFS, CCUnionFind} {
+		ccs, err := algo(m)
+
+		ut.Nil(t, err)
+		ut.Equal(t, 1, len(ccs))
+		ut.Equal(t, g.VertexCount(), len(ccs[0]))
+	}
+
+	for _, algo := range []MSTAlgo{MSTPrim, MSTKruskal} {
+		mstG, err := algo(g)
+		ut.Nil(t, err)
+
+		mstM, err := algo(m)
+		ut.Nil(t, err)
+
+		wtG, wtM := 0.0, 0.0
+
+		for i := range mstG {
+			wtG += mstG[i].Wt
+			wtM += mstM[i].Wt
+		}
+
+		ut.Equal(t, wtG, wtM)
+	}
+
+	cores, _, err := KCore(m)
+
+	ut.Nil(t, err)
+
+	coresG, _, err := KCore(g)
+
+	ut.Nil(t, err)
+
+	for v := range cores {
+		ut.Equal(t, coresG[v], cores[v])
+	}
+}
+
+func TestGraph_implicit(t *testing.T) {
+	g := gridGraph{4, 5}
+
+	tree, err := BFS(g, 0)
+
+	ut.Nil(t, err)
+
+	for v := range tree {
+		c := g.Item(v).(gridCell)
+		ut.Equal(t, float64(c.row+c.col), tree[v].Distance)
+	}
+
+	ccs, err := CCDFS(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, len(ccs))
+
+	mst, err := MSTKruskal(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, g.VertexCount()-1, len(mst))
+
+	stats, err := Triangles(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, stats.Total)
+
+	_, err = HamiltonianCycle(g)
+
+	ut.Nil(t, err)
+
+	_, cost, err := TSPHeldKarp(gridGraph{2, 2})
+
+	ut.Nil(t, err)
+	ut.Equal(t, 4.0, cost)
+
+	_, ok := g.VertexIndex(gridCell{4, 0})
+
+	ut.False(t, ok)
+}
+
+func TestGraph_csr(t *testing.T) {
+	for _, input := range []string{ut.UDGSimple, ut.UDGDeps, ut.UDGClx, ut.UDGDress} {
+		g, _, err := ds.Parse(input)
+
+		ut.Nil(t, err)
+
+		c := ds.NewCSR(g)
+
+		for v := range g.V {
+			treeG, err := BFS(g, v)
+			ut.Nil(t, err)
+
+			treeC, err := BFS(c, v)
+			ut.Nil(t, err)
+
+			ut.Equal(t, len(treeG), len(treeC))
+
+			for u := range treeG {
+				ut.Equal(t, treeG[u], treeC[u])
+			}
+		}
+
+		fstG, tpsG, err := DFS(g, true)
+		ut.Nil(t, err)
+
+		fstC, tpsC, err := DFS(c, true)
+		ut.Nil(t, err)
+
+		for v := range fstG {
+			ut.Equal(t, fstG[v], fstC[v])
+		}
+
+		ut.Equal(t, len(tpsG.Forward), len(tpsC.Forward))
+		ut.Equal(t, len(tpsG.Back), len(tpsC.Back))
+		ut.Equal(t, len(tpsG.Cross), len(tpsC.Cross))
+
+		for _, algo := range []SCCAlgo{SCCKosaraju, SCCTarjan} {
+			sccsG, err := algo(g)
+			ut.Nil(t, err)
+
+			sccsC, err := algo(c)
+			ut.Nil(t, err)
+
+			ut.Equal(t, len(sccsG), len(sccsC))
+
+			for i := range sccsG {
+				ut.Equal(t, len(sccsG[i]), len(sccsC[i]))
+
+				for j := range sccsG[i] {
+					ut.Equal(t, sccsG[i][j], sccsC[i][j])
+				}
+			}
+		}
+	}
+
+	for _, input := range []string{ut.UUGSimple, ut.UUGDisc, ut.WUGSimple} {
+		g, _, err := ds.Parse(input)
+
+		ut.Nil(t, err)
+
+		c := ds.NewCSR(g)
+
+		for _, algo := range []CCAlgo{CCDFS, CCUnionFind} {
+			ccsG, err := algo(g)
+			ut.Nil(t, err)
+
+			ccsC, err := algo(c)
+			ut.Nil(t, err)
+
+			ut.Equal(t, len(ccsG), len(ccsC))
+
+			for i := range ccsG {
+				ut.Equal(t, len(ccsG[i]), len(ccsC[i]))
+
+				for j := range ccsG[i] {
+					ut.Equal(t, ccsG[i][j], ccsC[i][j])
+				}
+			}
+		}
+	}
+}
+
+func TestGraph_multigraph(t *testing.T) {
+	for _, input := range []string{ut.UDGSimple, ut.UDGDeps, ut.UDGClx, ut.UDGDress} {
+		g, _, err := ds.Parse(input)
+
+		ut.Nil(t, err)
+
+		mg := doubled(t, g)
+
+		ut.Equal(t, 2*g.EdgeCount(), mg.EdgeCount())
+
+		for v := range g.V {
+			treeG, err := BFS(g, v)
+			ut.Nil(t, err)
+
+			treeM, err := BFS(mg, v)
+			ut.Nil(t, err)
+
+			for u := range treeG {
+				ut.Equal(t, treeG[u], treeM[u])
+			}
+		}
+
+		for _, algo := range []SCCAlgo{SCCKosaraju, SCCTarjan} {
+			sccsG, err := algo(g)
+			ut.Nil(t, err)
+
+			sccsM, err := algo(mg)
+			ut.Nil(t, err)
+
+			setsG, setsM := sortedSets(sccsG), sortedSets(sccsM)
+
+			ut.Equal(t, len(setsG), len(setsM))
+
+			for i := range setsG {
+				ut.Equal(t, len(setsG[i]), len(setsM[i]))
+
+				for j := range setsG[i] {
+					ut.Equal(t, setsG[i][j], setsM[i][j])
+				}
+			}
+		}
+	}
+
+	for _, input := range []string{ut.UUGSimple, ut.UUGDisc, ut.WUGSimple} {
+		g, _, err := ds.Parse(input)
+
+		ut.Nil(t, err)
+
+		mg := doubled(t, g)
+
+		ut.Equal(t, 2*g.EdgeCount(), mg.EdgeCount())
+
+		for _, algo := range []CCAlgo{CCDFS, CCUnionFind} {
+			ccsG, err := algo(g)
+			ut.Nil(t, err)
+
+			ccsM, err := algo(mg)
+			ut.Nil(t, err)
+
+			ut.Equal(t, len(ccsG), len(ccsM))
+		}
+
+		for _, algo := range []MSTAlgo{MSTPrim, MSTKruskal} {
+			mstG, errG := algo(g)
+			mstM, errM := algo(mg)
+
+			// Prim needs a connected graph
+			ut.Equal(t, errG == nil, errM == nil)
+			ut.Equal(t, len(mstG), len(mstM))
+
+			wtG, wtM := 0.0, 0.0
+
+			for i := range mstG {
+				wtG += mstG[i].Wt
+				wtM += mstM[i].Wt
+			}
+
+			ut.Equal(t, wtG, wtM)
+		}
+
+		coresG, _, err := KCore(g)
+		ut.Nil(t, err)
+
+		coresM, _, err := KCore(mg)
+		ut.Nil(t, err)
+
+		for v := range coresG {
+			ut.Equal(t, coresG[v], coresM[v])
+		}
+	}
+}
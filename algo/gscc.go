@@ -30,7 +30,7 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func GSCC(g *ds.G) (*ds.G, []SCC, error) {
+func GSCC(g ds.Graph) (*ds.G, []SCC, error) {
 	if g.Undirected() {
 		return nil, nil, ds.ErrUndirected
 	}
@@ -65,7 +65,7 @@ func GSCC(g *ds.G) (*ds.G, []SCC, error) {
 		items := make([]ds.Item, len(sccs[id]))
 
 		for i, v := range sccs[id] {
-			items[i] = g.V[v].Item
+			items[i] = g.Item(v)
 		}
 
 		gscc.AddVertex(&ds.Group{
@@ -85,12 +85,12 @@ func GSCC(g *ds.G) (*ds.G, []SCC, error) {
 	// skipped, since it is the last one in that order.
 	for srcId := len(sccs) - 1; srcId > 0; srcId-- {
 		for _, v := range sccs[srcId] {
-			for _, e := range g.V[v].E {
+			g.ForEachEdge(v, func(e ds.GE) {
 				dstId := vtxSCC[e.Dst]
 
 				// vertices in the same SCC, skip.
 				if srcId == dstId {
-					continue
+					return
 				}
 
 				// Since the same slice is used by all components
@@ -106,7 +106,7 @@ func GSCC(g *ds.G) (*ds.G, []SCC, error) {
 
 				// edge between SCCs already exists, skip.
 				if gsccAdj[dstId] == srcId {
-					continue
+					return
 				}
 
 				// Since the SCC list and the GSCC vertex list are aligned,
@@ -120,7 +120,7 @@ func GSCC(g *ds.G) (*ds.G, []SCC, error) {
 
 				// marking the edge for the current SCC
 				gsccAdj[dstId] = srcId
-			}
+			})
 		}
 
 		// Attempting to capitalize on the property that there will
@@ -0,0 +1,344 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+MaxExactVertices is the largest number of vertices accepted by the exact algorithms that need
+to examine every subset of vertices (HamiltonianPath, HamiltonianCycle and TSPHeldKarp).
+Their running time grows as 2^V, so graphs with more vertices are rejected with an
+ErrTooLarge error, instead of running for hours or exhausting memory.
+*/
+const MaxExactVertices = 20
+
+/*
+ErrTooLarge represents the rejection of a graph by an algorithm that
+would need too many resources to process a graph of its size.
+*/
+type ErrTooLarge struct {
+	// Count is the number of vertices in the graph.
+	Count int
+
+	// Max is the largest number of vertices accepted by the algorithm.
+	Max int
+}
+
+func (e ErrTooLarge) Error() string {
+	return fmt.Sprintf("graph too large: %d vertices, at most %d supported", e.Count, e.Max)
+}
+
+/*
+A Tour holds the vertices of a cycle that visits every vertex of a graph exactly once,
+in visiting order, starting from the first vertex of the graph. The edge that leads
+from the last vertex of the tour back to the first one is implied.
+*/
+type Tour []int
+
+// checkExact rejects graphs that are too large for the exact algorithms.
+func checkExact(g ds.Graph) error {
+	if g.VertexCount() > MaxExactVertices {
+		return ErrTooLarge{g.VertexCount(), MaxExactVertices}
+	}
+
+	return nil
+}
+
+// adjMasks calculates, for every vertex, the set of its successors as a bit mask.
+func adjMasks(g ds.Graph) []uint32 {
+	res := make([]uint32, g.VertexCount())
+
+	for v := range res {
+		g.ForEachEdge(v, func(e ds.GE) {
+			res[v] |= 1 << e.Dst
+		})
+	}
+
+	return res
+}
+
+/*
+hamReach calculates, for every set of vertices, the set of vertices where a path that visits
+every vertex in the set exactly once can end. Only sets that are supersets of start are
+considered: if start is 0, then paths can start from any vertex.
+*/
+func hamReach(adj []uint32, start uint32) []uint32 {
+	n := len(adj)
+	reach := make([]uint32, 1<<n)
+
+	if start == 0 {
+		for v := 0; v < n; v++ {
+			reach[1<<v] = 1 << v
+		}
+	} else {
+		reach[start] = start
+	}
+
+	// every set is built by adding a vertex to a smaller
+	// set, so processing sets in increasing numeric order
+	// guarantees that subsets are always ready first
+	for set := range reach {
+		for ends := reach[set]; ends != 0; ends &= ends - 1 {
+			v := bits.TrailingZeros32(ends)
+
+			for next := adj[v] &^ uint32(set); next != 0; next &= next - 1 {
+				u := bits.TrailingZeros32(next)
+				reach[set|1<<u] |= 1 << u
+			}
+		}
+	}
+
+	return reach
+}
+
+// hamPath rebuilds a path that visits every vertex in full, ending at v, from the results of hamReach.
+func hamPath(adj []uint32, reach []uint32, full uint32, v int) []int {
+	path := make([]int, bits.OnesCount32(full))
+
+	for i := len(path) - 1; i >= 0; i-- {
+		path[i] = v
+
+		full &^= 1 << v
+
+		if full == 0 {
+			break
+		}
+
+		// any vertex where a path visiting the rest of the set can
+		// end, and that has an edge to v, is a valid predecessor
+		for ends := reach[full]; ends != 0; ends &= ends - 1 {
+			u := bits.TrailingZeros32(ends)
+
+			if adj[u]&(1<<v) != 0 {
+				v = u
+				break
+			}
+		}
+	}
+
+	return path
+}
+
+/*
+HamiltonianPath implements a dynamic programming algorithm for finding a Hamiltonian path in a graph:
+a path that visits every vertex exactly once. If the graph has no such path, ds.ErrNoHamPath is returned.
+
+For every set S of vertices, the algorithm calculates the set of vertices where a path that visits every
+vertex in S exactly once can end. A path that visits S and ends at v can be extended to any successor of
+v that is not in S, and sets are processed in increasing numeric order of their bit mask representations,
+so every set is processed after all of its subsets. Sets of vertices being represented as bit masks allows
+the calculation for each set to be carried out using only bitwise operations.
+
+Since every subset of vertices is examined, graphs with more than MaxExactVertices vertices
+are rejected, and an ErrTooLarge error is returned instead.
+
+Expectations:
+	- The graph is correctly built.
+
+Complexity:
+	- Time:  O(2^V V²)
+	- Space: Θ(2^V)
+*/
+func HamiltonianPath(g ds.Graph) ([]int, error) {
+	if err := checkExact(g); err != nil {
+		return nil, err
+	}
+
+	n := g.VertexCount()
+
+	if n == 0 {
+		return []int{}, nil
+	}
+
+	adj := adjMasks(g)
+	reach := hamReach(adj, 0)
+	full := uint32(1<<n - 1)
+
+	if reach[full] == 0 {
+		return nil, ds.ErrNoHamPath
+	}
+
+	return hamPath(adj, reach, full, bits.TrailingZeros32(reach[full])), nil
+}
+
+/*
+HamiltonianCycle implements a dynamic programming algorithm for finding a Hamiltonian cycle in a graph:
+a cycle that visits every vertex exactly once. If the graph has no such cycle, ds.ErrNoHamCycle is returned.
+
+The algorithm is the same one used by HamiltonianPath, but only paths that start at the first vertex of the
+graph are considered: a cycle exists if one of these paths visits every vertex and ends at a vertex that
+has an edge leading back to the first vertex. An undirected cycle needs at least 3 vertices.
+
+Since every subset of vertices is examined, graphs with more than MaxExactVertices vertices
+are rejected, and an ErrTooLarge error is returned instead.
+
+Expectations:
+	- The graph is correctly built.
+
+Complexity:
+	- Time:  O(2^V V²)
+	- Space: Θ(2^V)
+*/
+func HamiltonianCycle(g ds.Graph) (Tour, error) {
+	if err := checkExact(g); err != nil {
+		return nil, err
+	}
+
+	n := g.VertexCount()
+
+	if n == 0 || (g.Undirected() && n < 3) {
+		return nil, ds.ErrNoHamCycle
+	}
+
+	adj := adjMasks(g)
+	reach := hamReach(adj, 1)
+	full := uint32(1<<n - 1)
+
+	for ends := reach[full]; ends != 0; ends &= ends - 1 {
+		v := bits.TrailingZeros32(ends)
+
+		if adj[v]&1 != 0 {
+			return Tour(hamPath(adj, reach, full, v)), nil
+		}
+	}
+
+	return nil, ds.ErrNoHamCycle
+}
+
+// weightMatrix calculates the weight of the lightest edge between every pair of vertices, +Inf if none.
+func weightMatrix(g ds.Graph) [][]float64 {
+	n := g.VertexCount()
+	res := make([][]float64, n)
+
+	for v := range res {
+		res[v] = make([]float64, n)
+
+		for u := range res[v] {
+			res[v][u] = math.Inf(1)
+		}
+	}
+
+	for v := range res {
+		g.ForEachEdge(v, func(e ds.GE) {
+			if e.Wt < res[e.Src][e.Dst] {
+				res[e.Src][e.Dst] = e.Wt
+			}
+		})
+	}
+
+	return res
+}
+
+/*
+TSPHeldKarp implements the Held-Karp algorithm for solving the Traveling Salesman Problem: finding
+a Hamiltonian cycle of minimum total weight in a graph with weighted edges. The optimal tour is returned
+along with its cost, and if the graph has no Hamiltonian cycle, ds.ErrNoHamCycle is returned.
+
+Every tour starts at the first vertex s of the graph, and for every set S of the other vertices and every
+vertex v in S, the algorithm calculates the cost C(S, v) of the cheapest path that starts at s, visits every
+vertex in S exactly once, and ends at v. The cheapest path reaching v through S must come from some other
+vertex u in S, through the cheapest path that visits S - {v} and ends at u, so:
+
+	C({v}, v) = w(s, v)
+	C(S, v) = min { C(S - {v}, u) + w(u, v) : u in S - {v} }
+
+The cost of the optimal tour is then the smallest C(S, v) + w(v, s), S being the set of all vertices but s.
+
+Since every subset of vertices is examined, graphs with more than MaxExactVertices vertices
+are rejected, and an ErrTooLarge error is returned instead.
+
+Expectations:
+	- The graph is correctly built.
+
+Complexity:
+	- Time:  O(2^V V²)
+	- Space: Θ(2^V V)
+*/
+func TSPHeldKarp(g ds.Graph) (Tour, float64, error) {
+	if err := checkExact(g); err != nil {
+		return nil, 0, err
+	}
+
+	n := g.VertexCount()
+	w := weightMatrix(g)
+
+	if n == 0 || (g.Undirected() && n < 3) {
+		return nil, 0, ds.ErrNoHamCycle
+	}
+
+	if n == 1 {
+		if math.IsInf(w[0][0], 1) {
+			return nil, 0, ds.ErrNoHamCycle
+		}
+
+		return Tour{0}, w[0][0], nil
+	}
+
+	// the first vertex is always the start of the tour,
+	// so it is left out of the sets: vertex v is
+	// represented by bit v-1 in a set
+	m := n - 1
+	sets := 1 << m
+
+	cost := make([]float64, sets*m)
+	prev := make([]int8, sets*m)
+
+	for i := range cost {
+		cost[i] = math.Inf(1)
+		prev[i] = -1
+	}
+
+	for v := 0; v < m; v++ {
+		cost[(1<<v)*m+v] = w[0][v+1]
+	}
+
+	for set := 1; set < sets; set++ {
+		for ends := uint32(set); ends != 0; ends &= ends - 1 {
+			v := bits.TrailingZeros32(ends)
+			cv := cost[set*m+v]
+
+			if math.IsInf(cv, 1) {
+				continue
+			}
+
+			for next := ^uint32(set) & uint32(sets-1); next != 0; next &= next - 1 {
+				u := bits.TrailingZeros32(next)
+				idx := (set|1<<u)*m + u
+
+				if c := cv + w[v+1][u+1]; c < cost[idx] {
+					cost[idx] = c
+					prev[idx] = int8(v)
+				}
+			}
+		}
+	}
+
+	full := sets - 1
+	best := math.Inf(1)
+	last := -1
+
+	for v := 0; v < m; v++ {
+		if c := cost[full*m+v] + w[v+1][0]; c < best {
+			best = c
+			last = v
+		}
+	}
+
+	if last == -1 {
+		return nil, 0, ds.ErrNoHamCycle
+	}
+
+	tour := make(Tour, n)
+
+	for i, set, v := n-1, full, last; i > 0; i-- {
+		tour[i] = v + 1
+
+		v, set = int(prev[set*m+v]), set&^(1<<v)
+	}
+
+	return tour, best, nil
+}
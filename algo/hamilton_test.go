@@ -0,0 +1,279 @@
+package algo
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// hamCheck checks that a sequence of vertices visits every vertex once, following existing edges.
+func hamCheck(t *testing.T, g *ds.G, path []int, cycle bool) float64 {
+	ut.Equal(t, g.VertexCount(), len(path))
+
+	seen := make([]bool, g.VertexCount())
+	cost := 0.0
+
+	for i, v := range path {
+		ut.False(t, seen[v])
+		seen[v] = true
+
+		next := i + 1
+
+		if next == len(path) {
+			if !cycle {
+				break
+			}
+
+			next = 0
+		}
+
+		vIdx, eIdx, ok := g.EdgeIndex(g.V[v].Item, g.V[path[next]].Item)
+
+		ut.True(t, ok)
+
+		cost += g.V[vIdx].E[eIdx].Wt
+	}
+
+	return cost
+}
+
+// tspBrute finds the cost of an optimal tour by trying every permutation of the vertices.
+func tspBrute(g *ds.G) float64 {
+	w := weightMatrix(g)
+	best := math.Inf(1)
+
+	perm := make([]int, g.VertexCount())
+	used := make([]bool, g.VertexCount())
+
+	var visit func(int, float64)
+
+	visit = func(i int, cost float64) {
+		if i == len(perm) {
+			best = math.Min(best, cost+w[perm[i-1]][0])
+			return
+		}
+
+		for v := 1; v < len(perm); v++ {
+			if used[v] {
+				continue
+			}
+
+			used[v] = true
+			perm[i] = v
+
+			visit(i+1, cost+w[perm[i-1]][v])
+
+			used[v] = false
+		}
+	}
+
+	visit(1, 0)
+
+	return best
+}
+
+func hamLarge() *ds.G {
+	g := ds.NewGraph()
+
+	for i := 0; i <= MaxExactVertices; i++ {
+		g.AddVertex(ds.Text(strconv.Itoa(i)))
+	}
+
+	return g
+}
+
+func TestHamiltonianPath(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		found bool
+	}{
+		{
+			desc:  "undirected",
+			input: ut.UUGSimple,
+			found: true,
+		},
+		{
+			desc:  "directed",
+			input: ut.UDGSimple,
+			found: false,
+		},
+		{
+			desc:  "dag",
+			input: ut.UDGDress,
+			found: false,
+		},
+		{
+			desc: "star",
+			input: `
+			graph
+			a#b,c,d
+			b#a
+			c#a
+			d#a
+			`,
+			found: false,
+		},
+		{
+			desc: "chain",
+			input: `
+			digraph
+			a#
+			b#c
+			c#a
+			`,
+			found: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g, _, err := ds.Parse(tc.input)
+
+			ut.Nil(t, err)
+
+			path, err := HamiltonianPath(g)
+
+			if !tc.found {
+				ut.True(t, errors.Is(err, ds.ErrNoHamPath))
+				return
+			}
+
+			ut.Nil(t, err)
+
+			hamCheck(t, g, path, false)
+		})
+	}
+}
+
+func TestHamiltonianCycle(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		found bool
+	}{
+		{
+			desc:  "undirected",
+			input: ut.WUGSimple,
+			found: true,
+		},
+		{
+			desc:  "pendant vertex",
+			input: ut.UUGSimple,
+			found: false,
+		},
+		{
+			desc:  "dag",
+			input: ut.UDGDress,
+			found: false,
+		},
+		{
+			desc: "directed",
+			input: `
+			digraph
+			a#b
+			b#c
+			c#d,a
+			d#a
+			`,
+			found: true,
+		},
+		{
+			desc: "single edge",
+			input: `
+			graph
+			a#b
+			b#a
+			`,
+			found: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g, _, err := ds.Parse(tc.input)
+
+			ut.Nil(t, err)
+
+			tour, err := HamiltonianCycle(g)
+
+			if !tc.found {
+				ut.True(t, errors.Is(err, ds.ErrNoHamCycle))
+				return
+			}
+
+			ut.Nil(t, err)
+			ut.Equal(t, 0, tour[0])
+
+			hamCheck(t, g, tour, true)
+		})
+	}
+}
+
+func TestTSPHeldKarp(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+	}{
+		{
+			desc:  "undirected",
+			input: ut.WUGSimple,
+		},
+		{
+			desc: "directed",
+			input: `
+			digraph
+			a#b:1,c:9,d:3
+			b#a:2,c:4,d:8
+			c#a:7,b:1,d:2
+			d#a:3,b:6,c:5
+			`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g, _, err := ds.Parse(tc.input)
+
+			ut.Nil(t, err)
+
+			tour, cost, err := TSPHeldKarp(g)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 0, tour[0])
+			ut.Equal(t, tspBrute(g), cost)
+			ut.Equal(t, cost, hamCheck(t, g, tour, true))
+		})
+	}
+}
+
+func TestTSPHeldKarp_no_cycle(t *testing.T) {
+	g, _, err := ds.Parse(ut.UUGSimple)
+
+	ut.Nil(t, err)
+
+	_, _, err = TSPHeldKarp(g)
+
+	ut.True(t, errors.Is(err, ds.ErrNoHamCycle))
+}
+
+func TestExact_too_large(t *testing.T) {
+	g := hamLarge()
+
+	var errLarge ErrTooLarge
+
+	_, err := HamiltonianPath(g)
+	ut.True(t, errors.As(err, &errLarge))
+	ut.Equal(t, MaxExactVertices+1, errLarge.Count)
+	ut.Equal(t, MaxExactVertices, errLarge.Max)
+
+	_, err = HamiltonianCycle(g)
+	ut.True(t, errors.As(err, &errLarge))
+
+	_, _, err = TSPHeldKarp(g)
+	ut.True(t, errors.As(err, &errLarge))
+}
@@ -0,0 +1,555 @@
+package algo
+
+import (
+	"github.com/vc-souza/gga/ds"
+)
+
+// IsoOpt configures how vertices and edges are compared by the isomorphism algorithms.
+type IsoOpt func(*isoPreds)
+
+/*
+isoPreds is an auxiliary type used only by the isomorphism algorithms
+to hold the optional predicates used to compare vertices and edges.
+*/
+type isoPreds struct {
+	vertex func(ds.Item, ds.Item) bool
+	edge   func(float64, float64) bool
+}
+
+// MatchVertices only allows vertices to be matched if the predicate holds for their Items.
+func MatchVertices(pred func(ds.Item, ds.Item) bool) IsoOpt {
+	return func(p *isoPreds) {
+		p.vertex = pred
+	}
+}
+
+// MatchEdges only allows edges to be matched if the predicate holds for their weights.
+func MatchEdges(pred func(float64, float64) bool) IsoOpt {
+	return func(p *isoPreds) {
+		p.edge = pred
+	}
+}
+
+// SameLabel is a vertex predicate that holds when both Items have the same label.
+func SameLabel(a, b ds.Item) bool {
+	return a.Label() == b.Label()
+}
+
+// SameWeight is an edge predicate that holds when both edges have the same weight.
+func SameWeight(a, b float64) bool {
+	return a == b
+}
+
+/*
+isoGraph is an auxiliary type used only by the isomorphism algorithms, holding
+adjacency data of a graph in a format that allows for O(1) edge lookups.
+*/
+type isoGraph struct {
+	g ds.Graph
+
+	// succ maps the successors of each vertex to the weight of the edge reaching them.
+	succ []map[int]float64
+
+	// pred maps the predecessors of each vertex to the weight of the edge leaving them.
+	pred []map[int]float64
+
+	// nb lists the distinct neighbors of each vertex, in either direction, loops excluded.
+	nb [][]int
+}
+
+func newIsoGraph(g ds.Graph) *isoGraph {
+	count := g.VertexCount()
+
+	res := &isoGraph{
+		g:    g,
+		succ: make([]map[int]float64, count),
+		nb:   make([][]int, count),
+	}
+
+	for v := range res.succ {
+		res.succ[v] = map[int]float64{}
+	}
+
+	// undirected graphs represent both directions
+	// of an edge, so successors and predecessors
+	// of any vertex are the same
+	if g.Directed() {
+		res.pred = make([]map[int]float64, count)
+
+		for v := range res.pred {
+			res.pred[v] = map[int]float64{}
+		}
+	} else {
+		res.pred = res.succ
+	}
+
+	for v := 0; v < count; v++ {
+		g.ForEachEdge(v, func(e ds.GE) {
+			if _, ok := res.succ[e.Src][e.Dst]; ok {
+				return
+			}
+
+			res.succ[e.Src][e.Dst] = e.Wt
+
+			if g.Directed() {
+				res.pred[e.Dst][e.Src] = e.Wt
+			}
+		})
+	}
+
+	var preds [][]int
+
+	if g.Directed() {
+		preds = make([][]int, count)
+
+		for v := 0; v < count; v++ {
+			g.ForEachEdge(v, func(e ds.GE) {
+				preds[e.Dst] = append(preds[e.Dst], e.Src)
+			})
+		}
+	}
+
+	// the neighbors of each vertex are listed in adjacency order, followed by
+	// its predecessors in vertex order, so that the search is deterministic
+	seen := make([]int, count)
+
+	for v := 0; v < count; v++ {
+		stamp := v + 1
+		seen[v] = stamp
+
+		add := func(u int) {
+			if seen[u] == stamp {
+				return
+			}
+
+			seen[u] = stamp
+			res.nb[v] = append(res.nb[v], u)
+		}
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			add(e.Dst)
+		})
+
+		if preds != nil {
+			for _, u := range preds[v] {
+				add(u)
+			}
+		}
+	}
+
+	return res
+}
+
+/*
+vf2 is an auxiliary type used only by the isomorphism algorithms,
+holding the state of the search for mappings between two graphs.
+*/
+type vf2 struct {
+	isoPreds
+
+	// pat is the graph whose vertices are being mapped.
+	pat *isoGraph
+
+	// tgt is the graph whose vertices the pattern is being mapped to.
+	tgt *isoGraph
+
+	// iso flags a search for isomorphisms, instead of subgraph isomorphisms.
+	iso bool
+
+	// coreP maps vertices of the pattern to vertices of the target, -1 if unmapped.
+	coreP []int
+
+	// coreT maps vertices of the target to vertices of the pattern, -1 if unmapped.
+	coreT []int
+
+	/*
+		termP records the depth at which a vertex of the pattern joined the terminal set:
+		the set of vertices adjacent to a mapped vertex. A value of 0 means it hasn't.
+	*/
+	termP []int
+
+	/*
+		termT records the depth at which a vertex of the target joined the terminal set:
+		the set of vertices adjacent to a mapped vertex. A value of 0 means it hasn't.
+	*/
+	termT []int
+
+	// order is the order in which the vertices of the pattern are mapped.
+	order []int
+
+	// parent holds, for each position in order, an earlier neighbor in order, or -1.
+	parent []int
+
+	// fn is called for every complete mapping, and stops the search by returning false.
+	fn func([]int) bool
+}
+
+func newVF2(pat, tgt ds.Graph, iso bool, opts []IsoOpt) *vf2 {
+	s := &vf2{
+		pat: newIsoGraph(pat),
+		tgt: newIsoGraph(tgt),
+		iso: iso,
+	}
+
+	for _, opt := range opts {
+		opt(&s.isoPreds)
+	}
+
+	s.coreP = make([]int, pat.VertexCount())
+	s.coreT = make([]int, tgt.VertexCount())
+
+	for i := range s.coreP {
+		s.coreP[i] = -1
+	}
+
+	for i := range s.coreT {
+		s.coreT[i] = -1
+	}
+
+	s.termP = make([]int, pat.VertexCount())
+	s.termT = make([]int, tgt.VertexCount())
+
+	s.buildOrder()
+
+	return s
+}
+
+/*
+buildOrder sorts the vertices of the pattern so that, whenever possible, the next vertex
+to be mapped is adjacent to a vertex that was mapped before it, which reduces the number
+of candidates for that vertex to the neighbors of the image of its mapped neighbor.
+Each connected component of the pattern is explored in BFS order, starting from its
+vertex of highest degree.
+*/
+func (s *vf2) buildOrder() {
+	count := len(s.coreP)
+
+	s.order = make([]int, 0, count)
+	s.parent = make([]int, 0, count)
+
+	queued := make([]bool, count)
+	queue := ds.NewQueue[int]()
+	from := make([]int, count)
+
+	for len(s.order) < count {
+		root := -1
+
+		for v := 0; v < count; v++ {
+			if queued[v] {
+				continue
+			}
+
+			if root == -1 || len(s.pat.nb[v]) > len(s.pat.nb[root]) {
+				root = v
+			}
+		}
+
+		queued[root] = true
+		from[root] = -1
+
+		queue.Enqueue(root)
+
+		for !queue.Empty() {
+			v, _ := queue.Dequeue()
+
+			s.parent = append(s.parent, from[v])
+			s.order = append(s.order, v)
+
+			for _, u := range s.pat.nb[v] {
+				if queued[u] {
+					continue
+				}
+
+				queued[u] = true
+				from[u] = v
+
+				queue.Enqueue(u)
+			}
+		}
+	}
+}
+
+// compatible checks whether the edge weights w1 and w2 can be matched.
+func (s *vf2) compatible(w1, w2 float64) bool {
+	return s.edge == nil || s.edge(w1, w2)
+}
+
+// covers checks that every edge in adjP from p to a mapped vertex has an image in adjT, from g.
+func (s *vf2) covers(adjP map[int]float64, p int, adjT map[int]float64) bool {
+	for p2, wP := range adjP {
+		if p2 == p {
+			continue
+		}
+
+		g2 := s.coreP[p2]
+
+		if g2 == -1 {
+			continue
+		}
+
+		wT, ok := adjT[g2]
+
+		if !ok || !s.compatible(wP, wT) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reflects checks that every edge in adjT from g to a mapped vertex has a preimage in adjP.
+func (s *vf2) reflects(adjT map[int]float64, g int, adjP map[int]float64) bool {
+	for g2 := range adjT {
+		if g2 == g {
+			continue
+		}
+
+		p2 := s.coreT[g2]
+
+		if p2 == -1 {
+			continue
+		}
+
+		if _, ok := adjP[p2]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookahead counts the unmapped neighbors of v that are in the terminal set, and the ones that are not.
+func lookahead(nb []int, core, term []int) (int, int) {
+	inTerm, out := 0, 0
+
+	for _, u := range nb {
+		if core[u] != -1 {
+			continue
+		}
+
+		if term[u] != 0 {
+			inTerm++
+		} else {
+			out++
+		}
+	}
+
+	return inTerm, out
+}
+
+// feasible checks whether the pattern vertex p can be mapped to the target vertex g.
+func (s *vf2) feasible(p, g int) bool {
+	if s.vertex != nil && !s.vertex(s.pat.g.Item(p), s.tgt.g.Item(g)) {
+		return false
+	}
+
+	wP, loopP := s.pat.succ[p][p]
+	wT, loopT := s.tgt.succ[g][g]
+
+	if loopP != loopT {
+		return false
+	}
+
+	if loopP && !s.compatible(wP, wT) {
+		return false
+	}
+
+	if !s.covers(s.pat.succ[p], p, s.tgt.succ[g]) {
+		return false
+	}
+
+	if !s.reflects(s.tgt.succ[g], g, s.pat.succ[p]) {
+		return false
+	}
+
+	if s.pat.g.Directed() {
+		if !s.covers(s.pat.pred[p], p, s.tgt.pred[g]) {
+			return false
+		}
+
+		if !s.reflects(s.tgt.pred[g], g, s.pat.pred[p]) {
+			return false
+		}
+	}
+
+	termP, outP := lookahead(s.pat.nb[p], s.coreP, s.termP)
+	termT, outT := lookahead(s.tgt.nb[g], s.coreT, s.termT)
+
+	if s.iso {
+		return termP == termT && outP == outT
+	}
+
+	return termP <= termT && outP <= outT
+}
+
+// join adds v, and its neighbors, to a terminal set, recording the depth where they joined.
+func join(nb []int, v int, term []int, depth int) {
+	if term[v] == 0 {
+		term[v] = depth
+	}
+
+	for _, u := range nb {
+		if term[u] == 0 {
+			term[u] = depth
+		}
+	}
+}
+
+// leave undoes a call to join at the same depth.
+func leave(nb []int, v int, term []int, depth int) {
+	if term[v] == depth {
+		term[v] = 0
+	}
+
+	for _, u := range nb {
+		if term[u] == depth {
+			term[u] = 0
+		}
+	}
+}
+
+// match extends the current mapping, returning false if the search should stop.
+func (s *vf2) match(depth int) bool {
+	if depth == len(s.order) {
+		m := make([]int, len(s.coreP))
+		copy(m, s.coreP)
+
+		return s.fn(m)
+	}
+
+	p := s.order[depth]
+
+	var candidates []int
+
+	// if p has a neighbor that has already been mapped,
+	// then its image must be a neighbor of the image
+	if parent := s.parent[depth]; parent != -1 {
+		candidates = s.tgt.nb[s.coreP[parent]]
+	} else {
+		candidates = make([]int, len(s.coreT))
+
+		for g := range candidates {
+			candidates[g] = g
+		}
+	}
+
+	for _, g := range candidates {
+		if s.coreT[g] != -1 {
+			continue
+		}
+
+		if !s.feasible(p, g) {
+			continue
+		}
+
+		s.coreP[p] = g
+		s.coreT[g] = p
+
+		// using depth+1, since 0 flags
+		// vertices not in terminal sets
+		join(s.pat.nb[p], p, s.termP, depth+1)
+		join(s.tgt.nb[g], g, s.termT, depth+1)
+
+		more := s.match(depth + 1)
+
+		leave(s.pat.nb[p], p, s.termP, depth+1)
+		leave(s.tgt.nb[g], g, s.termT, depth+1)
+
+		s.coreP[p] = -1
+		s.coreT[g] = -1
+
+		if !more {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Isomorphic implements the VF2 algorithm for checking whether two graphs are isomorphic: if there exists
+a bijection between their vertices such that an edge (u, v) exists in the first graph if and only if
+the edge (f(u), f(v)) exists in the second graph. Optionally, vertices can also be required to have
+matching Items (MatchVertices), and edges to have matching weights (MatchEdges).
+
+VF2 builds the mapping incrementally, one pair of vertices at a time, backtracking whenever adding
+a pair would make the current partial mapping inconsistent. Besides checking the edges between the new
+pair and the vertices that have already been mapped, VF2 prunes the search using a 1-look-ahead rule:
+the vertices of each graph that are adjacent to the current mapping (terminal sets) must correspond,
+so the number of unmapped neighbors of both vertices in the pair, inside and outside their respective
+terminal sets, must be the same.
+
+If the graphs are isomorphic, the mapping is returned, with the vertex v of the first graph
+being mapped to the vertex m[v] of the second graph.
+
+Expectations:
+	- Both graphs are correctly built.
+	- Both graphs are directed, or both are undirected.
+
+Complexity:
+	- Time:  O(V! V), but usually much faster in practice.
+	- Space: Θ(V + E)
+*/
+func Isomorphic(g1, g2 ds.Graph, opts ...IsoOpt) ([]int, bool, error) {
+	if g1.Directed() != g2.Directed() {
+		return nil, false, ds.ErrMixedGraphs
+	}
+
+	if g1.VertexCount() != g2.VertexCount() || g1.EdgeCount() != g2.EdgeCount() {
+		return nil, false, nil
+	}
+
+	var res []int
+
+	s := newVF2(g1, g2, true, opts)
+
+	s.fn = func(m []int) bool {
+		res = m
+		return false
+	}
+
+	s.match(0)
+
+	return res, res != nil, nil
+}
+
+/*
+SubgraphMatches implements the VF2 algorithm for enumerating the embeddings of a pattern graph in
+a graph: mappings of every vertex of the pattern to a distinct vertex of the graph, such that
+an edge (u, v) exists in the pattern if and only if the edge (m[u], m[v]) exists in the graph.
+In other words, every match is an induced subgraph of the graph that is isomorphic to the pattern.
+Optionally, vertices can also be required to have matching Items (MatchVertices), and edges to have
+matching weights (MatchEdges), with predicates being called with pattern values first.
+
+Every match is passed to fn, with the vertex v of the pattern being mapped to the vertex m[v]
+of the graph, and the search goes on until fn returns false, or until all matches are found.
+Symmetric patterns produce multiple matches for the same set of vertices of the graph.
+
+The search is the same as the one described for Isomorphic, with the 1-look-ahead rule
+being relaxed: the pattern vertex cannot have more unmapped neighbors than the graph
+vertex it is being mapped to, inside and outside their respective terminal sets.
+
+Expectations:
+	- Both graphs are correctly built.
+	- Both graphs are directed, or both are undirected.
+
+Complexity:
+	- Time:  O(V^P P), P being the number of vertices in the pattern, but usually much faster in practice.
+	- Space: Θ(V + E)
+*/
+func SubgraphMatches(g, pattern ds.Graph, fn func([]int) bool, opts ...IsoOpt) error {
+	if g.Directed() != pattern.Directed() {
+		return ds.ErrMixedGraphs
+	}
+
+	if pattern.VertexCount() > g.VertexCount() {
+		return nil
+	}
+
+	s := newVF2(pattern, g, false, opts)
+	s.fn = fn
+
+	s.match(0)
+
+	return nil
+}
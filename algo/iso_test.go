@@ -0,0 +1,308 @@
+package algo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// isoReversed builds a copy of a graph with vertices and edges added in reverse order.
+func isoReversed(t *testing.T, g *ds.G, prefix string) *ds.G {
+	var res *ds.G
+
+	if g.Directed() {
+		res = ds.NewDigraph()
+	} else {
+		res = ds.NewGraph()
+	}
+
+	items := make([]ds.Item, g.VertexCount())
+
+	for v := len(g.V) - 1; v >= 0; v-- {
+		items[v] = ds.Text(prefix + g.V[v].Label())
+
+		_, err := res.AddVertex(items[v])
+		ut.Nil(t, err)
+	}
+
+	for v := len(g.V) - 1; v >= 0; v-- {
+		for e := len(g.V[v].E) - 1; e >= 0; e-- {
+			edge := g.V[v].E[e]
+
+			// the reverse copy of an undirected edge was already added
+			if _, _, ok := res.EdgeIndex(items[edge.Src], items[edge.Dst]); ok {
+				continue
+			}
+
+			_, _, err := res.AddEdge(items[edge.Src], items[edge.Dst], edge.Wt)
+			ut.Nil(t, err)
+		}
+	}
+
+	return res
+}
+
+// isoPreserved checks that a mapping between two graphs preserves their edges.
+func isoPreserved(t *testing.T, g1, g2 *ds.G, m []int) {
+	for v := range g1.V {
+		for _, e := range g1.V[v].E {
+			_, _, ok := g2.EdgeIndex(g2.V[m[e.Src]].Item, g2.V[m[e.Dst]].Item)
+			ut.True(t, ok)
+		}
+	}
+}
+
+func TestIsomorphic(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+	}{
+		{
+			desc:  "directed",
+			input: ut.UDGDeps,
+		},
+		{
+			desc:  "undirected",
+			input: ut.UUGSimple,
+		},
+		{
+			desc:  "weighted",
+			input: ut.WUGSimple,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g1, _, err := ds.Parse(tc.input)
+
+			ut.Nil(t, err)
+
+			g2 := isoReversed(t, g1, "r-")
+
+			m, ok, err := Isomorphic(g1, g2, MatchEdges(SameWeight))
+
+			ut.Nil(t, err)
+			ut.True(t, ok)
+			ut.Equal(t, g1.VertexCount(), len(m))
+
+			isoPreserved(t, g1, g2, m)
+			isoPreserved(t, g2, g1, invertMapping(m))
+
+			_, ok, err = Isomorphic(g1, g2, MatchVertices(SameLabel))
+
+			ut.Nil(t, err)
+			ut.False(t, ok)
+
+			_, ok, err = Isomorphic(g1, isoReversed(t, g1, ""), MatchVertices(SameLabel))
+
+			ut.Nil(t, err)
+			ut.True(t, ok)
+		})
+	}
+}
+
+func invertMapping(m []int) []int {
+	res := make([]int, len(m))
+
+	for i, v := range m {
+		res[v] = i
+	}
+
+	return res
+}
+
+func TestIsomorphic_not_isomorphic(t *testing.T) {
+	// same vertex count, edge count and degree
+	// sequence: a 6-cycle, and two 3-cycles
+	g1, _, err := ds.Parse(`
+	graph
+	a#b,f
+	b#a,c
+	c#b,d
+	d#c,e
+	e#d,f
+	f#e,a
+	`)
+
+	ut.Nil(t, err)
+
+	g2, _, err := ds.Parse(`
+	graph
+	a#b,c
+	b#a,c
+	c#a,b
+	d#e,f
+	e#d,f
+	f#d,e
+	`)
+
+	ut.Nil(t, err)
+
+	_, ok, err := Isomorphic(g1, g2)
+
+	ut.Nil(t, err)
+	ut.False(t, ok)
+}
+
+func TestIsomorphic_mixed(t *testing.T) {
+	g1, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	g2, _, err := ds.Parse(ut.UUGSimple)
+
+	ut.Nil(t, err)
+
+	_, _, err = Isomorphic(g1, g2)
+
+	ut.NotNil(t, err)
+	ut.True(t, errors.Is(err, ds.ErrUndefOp))
+
+	err = SubgraphMatches(g1, g2, func([]int) bool { return true })
+
+	ut.NotNil(t, err)
+	ut.True(t, errors.Is(err, ds.ErrUndefOp))
+}
+
+func TestSubgraphMatches(t *testing.T) {
+	g, _, err := ds.Parse(`
+	graph
+	a#b,c,d,e
+	b#a,c,d,e
+	c#a,b,d
+	d#a,b,c
+	e#a,b,f
+	f#e
+	g#
+	`)
+
+	ut.Nil(t, err)
+
+	cases := []struct {
+		desc    string
+		pattern string
+		expect  int
+	}{
+		{
+			// 5 triangles, with 6 automorphisms each
+			desc: "triangle",
+			pattern: `
+			graph
+			x#y,z
+			y#x,z
+			z#x,y
+			`,
+			expect: 30,
+		},
+		{
+			// induced paths: c-a-e, d-a-e, c-b-e,
+			// d-b-e, a-e-f, b-e-f, in both directions
+			desc: "path",
+			pattern: `
+			graph
+			x#y
+			y#x,z
+			z#y
+			`,
+			expect: 12,
+		},
+		{
+			// a-b-c-d, with 24 automorphisms,
+			// and either f or g, which are not
+			// adjacent to any of these vertices
+			desc: "clique and isolated vertex",
+			pattern: `
+			graph
+			x#y,z,w
+			y#x,z,w
+			z#x,y,w
+			w#x,y,z
+			v#
+			`,
+			expect: 48,
+		},
+		{
+			desc: "no matches",
+			pattern: `
+			graph
+			x#y,z,w,v
+			y#x,z,w,v
+			z#x,y,w,v
+			w#x,y,z,v
+			v#x,y,z,w
+			`,
+			expect: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			p, _, err := ds.Parse(tc.pattern)
+
+			ut.Nil(t, err)
+
+			count := 0
+
+			err = SubgraphMatches(g, p, func(m []int) bool {
+				isoPreserved(t, p, g, m)
+				count++
+				return true
+			})
+
+			ut.Nil(t, err)
+			ut.Equal(t, tc.expect, count)
+		})
+	}
+}
+
+func TestSubgraphMatches_stop(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	p, _, err := ds.Parse(`
+	digraph
+	x#y
+	y#
+	`)
+
+	ut.Nil(t, err)
+
+	count := 0
+
+	err = SubgraphMatches(g, p, func(m []int) bool {
+		count++
+		return false
+	})
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, count)
+}
+
+func TestSubgraphMatches_weighted(t *testing.T) {
+	g, _, err := ds.Parse(ut.WUGSimple)
+
+	ut.Nil(t, err)
+
+	p, _, err := ds.Parse(`
+	graph
+	x#y:2
+	y#x:2
+	`)
+
+	ut.Nil(t, err)
+
+	count := 0
+
+	err = SubgraphMatches(g, p, func(m []int) bool {
+		count++
+		return true
+	}, MatchEdges(SameWeight))
+
+	ut.Nil(t, err)
+
+	// c-i and f-g, in both directions
+	ut.Equal(t, 4, count)
+}
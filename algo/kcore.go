@@ -0,0 +1,141 @@
+package algo
+
+import (
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+KCore implements the Batagelj-Zaversnik algorithm for the k-core decomposition of an undirected graph.
+
+A k-core of a graph is a maximal subgraph in which every vertex has degree at least k, with the
+core number of a vertex being the largest k such that the vertex belongs to a k-core. The largest
+core number in the graph is called its degeneracy.
+
+The algorithm repeatedly removes the vertex of least remaining degree, and the degree of each
+vertex at the time of its removal is its core number. Vertices are kept in bucket queues indexed
+by their current degree, which makes both finding the vertex of least degree and decreasing the
+degree of its neighbors O(1) operations.
+
+The degree of a vertex is its number of distinct neighbors, so parallel edges are only counted
+once, and self-loops are ignored, since they never help a vertex into a higher core.
+
+Two lists are returned:
+  - The core number of every vertex, indexed by vertex.
+  - The degeneracy ordering of the graph: the order in which vertices were removed, with every
+  	vertex having at most d neighbors that appear later in the ordering, d being the degeneracy.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V)
+*/
+func KCore(g ds.Graph) ([]int, []int, error) {
+	if g.Directed() {
+		return nil, nil, ds.ErrDirected
+	}
+
+	count := g.VertexCount()
+	maxDeg := 0
+
+	// mark flags the neighbors already seen for a vertex,
+	// with a different stamp being used for each vertex,
+	// so that the slice never needs to be cleared
+	mark := make([]int, count)
+	stamp := 0
+
+	// using the number of distinct neighbors as the
+	// degree, so that repeated edges are not counted
+	deg := make([]int, count)
+
+	for v := range deg {
+		stamp++
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			if e.Dst == v || mark[e.Dst] == stamp {
+				return
+			}
+
+			mark[e.Dst] = stamp
+			deg[v]++
+		})
+
+		if deg[v] > maxDeg {
+			maxDeg = deg[v]
+		}
+	}
+
+	// bin[d] will hold the position in ord
+	// where the bucket for degree d starts
+	bin := make([]int, maxDeg+1)
+
+	for v := range deg {
+		bin[deg[v]]++
+	}
+
+	for d, start := 0, 0; d <= maxDeg; d++ {
+		size := bin[d]
+		bin[d] = start
+		start += size
+	}
+
+	// ord holds the vertices sorted by degree, with
+	// pos[v] being the position of v in ord
+	ord := make([]int, count)
+	pos := make([]int, count)
+
+	for v := range deg {
+		pos[v] = bin[deg[v]]
+		ord[pos[v]] = v
+		bin[deg[v]]++
+	}
+
+	// after the placement above, each bin points to
+	// the start of the next bucket, so shift it back
+	for d := maxDeg; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+
+	bin[0] = 0
+
+	for i := range ord {
+		v := ord[i]
+
+		stamp++
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			u := e.Dst
+
+			if mark[u] == stamp {
+				return
+			}
+
+			mark[u] = stamp
+
+			if deg[u] <= deg[v] {
+				return
+			}
+
+			// moving u to the start of its bucket, by swapping
+			// it with the first vertex in there, and then
+			// shrinking the bucket so that u ends up
+			// in the bucket for the degree below
+			du := deg[u]
+			pu := pos[u]
+			pw := bin[du]
+			w := ord[pw]
+
+			if u != w {
+				ord[pu], ord[pw] = w, u
+				pos[u], pos[w] = pw, pu
+			}
+
+			bin[du]++
+			deg[u]--
+		})
+	}
+
+	return deg, ord, nil
+}
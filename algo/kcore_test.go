@@ -0,0 +1,105 @@
+package algo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestKCore_directed(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	_, _, err = KCore(g)
+
+	ut.NotNil(t, err)
+	ut.True(t, errors.Is(err, ds.ErrUndefOp))
+}
+
+func TestKCore_undirected(t *testing.T) {
+	g, idx, err := ds.Parse(`
+	graph
+	a#b,c,d,e
+	b#a,c,d,e
+	c#a,b,d
+	d#a,b,c
+	e#a,b,f
+	f#e
+	g#
+	`)
+
+	ut.Nil(t, err)
+
+	expect := map[string]int{
+		"a": 3,
+		"b": 3,
+		"c": 3,
+		"d": 3,
+		"e": 2,
+		"f": 1,
+		"g": 0,
+	}
+
+	cores, ord, err := KCore(g)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, g.VertexCount(), len(cores))
+	ut.Equal(t, g.VertexCount(), len(ord))
+
+	for s, k := range expect {
+		ut.Equal(t, k, cores[idx(s)])
+	}
+
+	rank := make([]int, g.VertexCount())
+
+	for i, v := range ord {
+		rank[v] = i
+	}
+
+	// in a degeneracy ordering, every vertex has at most
+	// d neighbors that come after it, d being the degeneracy
+	for _, v := range ord {
+		later := 0
+
+		for _, e := range g.V[v].E {
+			if rank[e.Dst] > rank[v] {
+				later++
+			}
+		}
+
+		ut.True(t, later <= 3)
+	}
+}
+
+func TestKCore_multigraph(t *testing.T) {
+	g := ds.NewGraphWith(ds.Multigraph())
+
+	a, b, c, d := ds.Text("a"), ds.Text("b"), ds.Text("c"), ds.Text("d")
+
+	for _, v := range []ds.Text{a, b, c, d} {
+		_, err := g.AddVertex(v)
+		ut.Nil(t, err)
+	}
+
+	// a path a - b - c - d, with every edge
+	// repeated a few times, is still a 1-core
+	for i := 0; i < 3; i++ {
+		for _, p := range [][2]ds.Text{{a, b}, {b, c}, {c, d}} {
+			_, _, err := g.AddEdge(p[0], p[1], 0)
+			ut.Nil(t, err)
+		}
+	}
+
+	cores, ord, err := KCore(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, g.VertexCount(), len(ord))
+
+	for v := range cores {
+		ut.Equal(t, 1, cores[v])
+	}
+}
@@ -0,0 +1,857 @@
+package algo
+
+/*
+mwEdge is an auxiliary type used only by maxWeightMatching,
+representing a weighted edge between two vertices.
+*/
+type mwEdge struct {
+	u, v int
+	wt   float64
+}
+
+// Labels of the top-level blossoms of a mwMatcher, during a stage.
+const (
+	// mwFree marks blossoms that were not reached yet.
+	mwFree = 0
+
+	// mwOuter marks S-blossoms: blossoms at an even distance from a free vertex.
+	mwOuter = 1
+
+	// mwInner marks T-blossoms: blossoms at an odd distance from a free vertex.
+	mwInner = 2
+
+	// mwCrumb marks the S-blossoms already visited by scanBlossom.
+	mwCrumb = 4
+)
+
+// Kinds of dual updates of a mwMatcher, named after the event that limits the update.
+const (
+	// mwOptimal: the dual of a vertex reaches 0, and the matching is optimal.
+	mwOptimal = iota + 1
+
+	// mwFreeEdge: an edge between an S-vertex and a free vertex becomes tight.
+	mwFreeEdge
+
+	// mwOuterEdge: an edge between two S-blossoms becomes tight.
+	mwOuterEdge
+
+	// mwExpand: the dual of a T-blossom reaches 0, and it can be expanded.
+	mwExpand
+)
+
+/*
+mwMatcher is an auxiliary type used only by maxWeightMatching, holding the state of Edmonds'
+blossom algorithm. Endpoints of edges are identified by p: the endpoint 2k is the first vertex
+of the edge k, and the endpoint 2k+1 is its second vertex, so p^1 is the other endpoint of
+the same edge. Indexes up to n - 1 identify vertices (trivial blossoms), and indexes from n
+up to 2n - 1 identify non-trivial blossoms.
+*/
+type mwMatcher struct {
+	n     int
+	edges []mwEdge
+
+	// endVtx maps every endpoint to its vertex.
+	endVtx []int
+
+	// remote lists, for every vertex, the remote endpoints of its edges.
+	remote [][]int
+
+	// mate holds, for every vertex, the remote endpoint of its matched edge, or -1.
+	mate []int
+
+	// label holds the label of every top-level blossom, and of every vertex that belongs to one.
+	label []int
+
+	// labelEnd holds the remote endpoint of the edge through which a blossom got its label, or -1.
+	labelEnd []int
+
+	// top holds, for every vertex, the top-level blossom that contains it.
+	top []int
+
+	// parent holds the blossom that immediately contains every blossom, or -1 for top-level ones.
+	parent []int
+
+	// children lists the sub-blossoms of every non-trivial blossom, as a cycle that starts at its base.
+	children [][]int
+
+	// links lists, for every non-trivial blossom, the endpoints of the edges that connect its children:
+	// links[b][i] is the endpoint at children[b][i] of the edge to the next child in the cycle.
+	links [][]int
+
+	// base holds the base vertex of every blossom, or -1 for unused ones.
+	base []int
+
+	// bestEdge holds the least-slack edge to a different S-blossom, or -1.
+	bestEdge []int
+
+	// bestEdges lists, for every S-blossom, its least-slack edges to other S-blossoms,
+	// or nil if they were not computed yet.
+	bestEdges [][]int
+
+	// unused lists the indexes that are available for new blossoms.
+	unused []int
+
+	// dual holds the dual variables of vertices and blossoms.
+	dual []float64
+
+	// tight flags the edges known to have zero slack.
+	tight []bool
+
+	// queue holds the S-vertices whose edges were not scanned yet.
+	queue []int
+}
+
+func newMWMatcher(n int, edges []mwEdge) *mwMatcher {
+	m := &mwMatcher{
+		n:     n,
+		edges: edges,
+
+		endVtx:   make([]int, 2*len(edges)),
+		remote:   make([][]int, n),
+		mate:     make([]int, n),
+		label:    make([]int, 2*n),
+		labelEnd: make([]int, 2*n),
+		top:      make([]int, n),
+		parent:   make([]int, 2*n),
+		children: make([][]int, 2*n),
+		links:    make([][]int, 2*n),
+		base:     make([]int, 2*n),
+		bestEdge: make([]int, 2*n),
+
+		bestEdges: make([][]int, 2*n),
+		dual:      make([]float64, 2*n),
+		tight:     make([]bool, len(edges)),
+	}
+
+	maxWt := 0.0
+
+	for k, e := range edges {
+		m.endVtx[2*k] = e.u
+		m.endVtx[2*k+1] = e.v
+
+		m.remote[e.u] = append(m.remote[e.u], 2*k+1)
+		m.remote[e.v] = append(m.remote[e.v], 2*k)
+
+		if e.wt > maxWt {
+			maxWt = e.wt
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		m.mate[v] = -1
+		m.top[v] = v
+		m.base[v] = v
+		m.base[n+v] = -1
+		m.dual[v] = maxWt
+		m.unused = append(m.unused, n+v)
+	}
+
+	for b := 0; b < 2*n; b++ {
+		m.labelEnd[b] = -1
+		m.parent[b] = -1
+		m.bestEdge[b] = -1
+	}
+
+	return m
+}
+
+// slack calculates the slack of the edge k, which is never negative for an edge between S-blossoms.
+func (m *mwMatcher) slack(k int) float64 {
+	e := m.edges[k]
+	return m.dual[e.u] + m.dual[e.v] - 2*e.wt
+}
+
+// leaves calls fn for every vertex that belongs to the blossom b.
+func (m *mwMatcher) leaves(b int, fn func(int)) {
+	if b < m.n {
+		fn(b)
+		return
+	}
+
+	for _, t := range m.children[b] {
+		m.leaves(t, fn)
+	}
+}
+
+/*
+walk prepares a walk around the children of the blossom b, from the child at position i to the base,
+at position 0, returning the direction in which the walk has an even length (1 or -1), and a function
+that returns, for every position j, the endpoint at the child in j of the edge to the next child in
+that direction.
+*/
+func (m *mwMatcher) walk(b, i int) (int, func(int) int) {
+	links := m.links[b]
+	size := len(links)
+
+	// the cycle has an odd length, so walking forward
+	// from an odd position takes an even number of steps
+	if i%2 != 0 {
+		return 1, func(j int) int {
+			return links[j]
+		}
+	}
+
+	return -1, func(j int) int {
+		return links[(j+size-1)%size] ^ 1
+	}
+}
+
+// assignLabel labels the top-level blossom of w, which it reached through the endpoint p.
+func (m *mwMatcher) assignLabel(w, label, p int) {
+	b := m.top[w]
+
+	m.label[w], m.label[b] = label, label
+	m.labelEnd[w], m.labelEnd[b] = p, p
+	m.bestEdge[w], m.bestEdge[b] = -1, -1
+
+	switch label {
+	case mwOuter:
+		// b became an S-blossom: its vertices need to be scanned
+		m.leaves(b, func(v int) {
+			m.queue = append(m.queue, v)
+		})
+
+	case mwInner:
+		// b became a T-blossom: its mate becomes an S-blossom
+		base := m.base[b]
+		m.assignLabel(m.endVtx[m.mate[base]], mwOuter, m.mate[base]^1)
+	}
+}
+
+/*
+scanBlossom traces back from the S-vertices v and w, towards the free vertices of their alternating trees,
+returning the base of the new blossom that is formed if both paths meet, or -1 if they reach different
+free vertices, in which case an augmenting path was found.
+*/
+func (m *mwMatcher) scanBlossom(v, w int) int {
+	path := []int{}
+	base := -1
+
+	for v != -1 || w != -1 {
+		b := m.top[v]
+
+		if m.label[b]&mwCrumb != 0 {
+			base = m.base[b]
+			break
+		}
+
+		path = append(path, b)
+		m.label[b] = mwOuter | mwCrumb
+
+		if m.labelEnd[b] == -1 {
+			// reached a free vertex: stop
+			v = -1
+		} else {
+			// skipping the T-blossom along the way
+			v = m.endVtx[m.labelEnd[b]]
+			b = m.top[v]
+			v = m.endVtx[m.labelEnd[b]]
+		}
+
+		// alternating between both paths
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+
+	for _, b := range path {
+		m.label[b] = mwOuter
+	}
+
+	return base
+}
+
+// addBlossom creates a new blossom with the given base, through the edge k, which connects two S-vertices.
+func (m *mwMatcher) addBlossom(base, k int) {
+	v, w := m.edges[k].u, m.edges[k].v
+
+	bb := m.top[base]
+	bv := m.top[v]
+	bw := m.top[w]
+
+	b := m.unused[len(m.unused)-1]
+	m.unused = m.unused[:len(m.unused)-1]
+
+	m.base[b] = base
+	m.parent[b] = -1
+	m.parent[bb] = b
+
+	path := []int{}
+	links := []int{}
+
+	// tracing back from v to the base
+	for bv != bb {
+		m.parent[bv] = b
+		path = append(path, bv)
+		links = append(links, m.labelEnd[bv])
+		v = m.endVtx[m.labelEnd[bv]]
+		bv = m.top[v]
+	}
+
+	path = append(path, bb)
+
+	reverse(path)
+	reverse(links)
+
+	links = append(links, 2*k)
+
+	// tracing back from w to the base
+	for bw != bb {
+		m.parent[bw] = b
+		path = append(path, bw)
+		links = append(links, m.labelEnd[bw]^1)
+		w = m.endVtx[m.labelEnd[bw]]
+		bw = m.top[w]
+	}
+
+	m.children[b] = path
+	m.links[b] = links
+
+	m.label[b] = mwOuter
+	m.labelEnd[b] = m.labelEnd[bb]
+	m.dual[b] = 0
+
+	m.leaves(b, func(v int) {
+		// T-vertices become S-vertices
+		if m.label[m.top[v]] == mwInner {
+			m.queue = append(m.queue, v)
+		}
+
+		m.top[v] = b
+	})
+
+	// best holds, for every other S-blossom,
+	// the least-slack edge from b to it
+	best := make([]int, 2*m.n)
+
+	for i := range best {
+		best[i] = -1
+	}
+
+	consider := func(k int) {
+		// the endpoint of k that is outside of b
+		j := m.edges[k].v
+
+		if m.top[j] == b {
+			j = m.edges[k].u
+		}
+
+		bj := m.top[j]
+
+		if bj == b || m.label[bj] != mwOuter {
+			return
+		}
+
+		if best[bj] == -1 || m.slack(k) < m.slack(best[bj]) {
+			best[bj] = k
+		}
+	}
+
+	for _, s := range path {
+		if m.bestEdges[s] != nil {
+			for _, k := range m.bestEdges[s] {
+				consider(k)
+			}
+		} else {
+			m.leaves(s, func(v int) {
+				for _, p := range m.remote[v] {
+					consider(p / 2)
+				}
+			})
+		}
+
+		m.bestEdges[s] = nil
+		m.bestEdge[s] = -1
+	}
+
+	// not nil, even if empty, since it was computed
+	edges := []int{}
+
+	for _, k := range best {
+		if k != -1 {
+			edges = append(edges, k)
+		}
+	}
+
+	m.bestEdges[b] = edges
+	m.bestEdge[b] = -1
+
+	for _, k := range edges {
+		if m.bestEdge[b] == -1 || m.slack(k) < m.slack(m.bestEdge[b]) {
+			m.bestEdge[b] = k
+		}
+	}
+}
+
+/*
+expandBlossom turns the children of the blossom b into top-level blossoms. At the end of a stage, children
+with a zero dual are expanded as well, and in the middle of a stage, the children of a T-blossom are relabeled.
+*/
+func (m *mwMatcher) expandBlossom(b int, endStage bool) {
+	for _, s := range m.children[b] {
+		m.parent[s] = -1
+
+		if s < m.n {
+			m.top[s] = s
+		} else if endStage && m.dual[s] == 0 {
+			m.expandBlossom(s, endStage)
+		} else {
+			m.leaves(s, func(v int) {
+				m.top[v] = s
+			})
+		}
+	}
+
+	if !endStage && m.label[b] == mwInner {
+		m.relabel(b)
+	}
+
+	m.label[b], m.labelEnd[b] = mwFree, -1
+	m.children[b], m.links[b] = nil, nil
+	m.base[b] = -1
+	m.bestEdges[b] = nil
+	m.bestEdge[b] = -1
+
+	m.unused = append(m.unused, b)
+}
+
+/*
+relabel labels the children of the T-blossom b, which is being expanded: the ones along the even-length path
+from the child that got the label of b to the base become part of the alternating tree, and the other ones
+only keep a label if any of their vertices is reachable through an edge from an S-blossom.
+*/
+func (m *mwMatcher) relabel(b int) {
+	children := m.children[b]
+	size := len(children)
+
+	entry := m.top[m.endVtx[m.labelEnd[b]^1]]
+	j := indexOf(children, entry)
+
+	dir, link := m.walk(b, j)
+	next := func(j int) int {
+		return (j + dir + size) % size
+	}
+
+	p := m.labelEnd[b]
+
+	for j != 0 {
+		// relabeling the T-child, along with the edge to the next child,
+		// and moving past the next child, which is an S-child
+		m.label[m.endVtx[p^1]] = mwFree
+		m.label[m.endVtx[link(j)^1]] = mwFree
+		m.assignLabel(m.endVtx[p^1], mwInner, p)
+		m.tight[link(j)/2] = true
+
+		j = next(j)
+
+		p = link(j)
+		m.tight[p/2] = true
+
+		j = next(j)
+	}
+
+	// the base becomes a T-child, without
+	// labeling its mate, which is already labeled
+	bv := children[j]
+
+	m.label[m.endVtx[p^1]], m.label[bv] = mwInner, mwInner
+	m.labelEnd[m.endVtx[p^1]], m.labelEnd[bv] = p, p
+	m.bestEdge[bv] = -1
+
+	for j = next(j); children[j] != entry; j = next(j) {
+		bv = children[j]
+
+		if m.label[bv] == mwOuter {
+			continue
+		}
+
+		reached := -1
+
+		m.leaves(bv, func(v int) {
+			if reached == -1 && m.label[v] != mwFree {
+				reached = v
+			}
+		})
+
+		if reached != -1 {
+			m.label[reached] = mwFree
+			m.label[m.endVtx[m.mate[m.base[bv]]]] = mwFree
+			m.assignLabel(reached, mwInner, m.labelEnd[reached])
+		}
+	}
+}
+
+// augmentBlossom swaps matched and unmatched edges along the path from v to the base of the blossom b.
+func (m *mwMatcher) augmentBlossom(b, v int) {
+	// the child of b that contains v
+	t := v
+
+	for m.parent[t] != b {
+		t = m.parent[t]
+	}
+
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+
+	children, links := m.children[b], m.links[b]
+	size := len(children)
+
+	i := indexOf(children, t)
+	j := i
+
+	dir, link := m.walk(b, i)
+
+	for j != 0 {
+		j = (j + dir + size) % size
+		t = children[j]
+		p := link(j)
+
+		if t >= m.n {
+			m.augmentBlossom(t, m.endVtx[p])
+		}
+
+		j = (j + dir + size) % size
+		t = children[j]
+
+		if t >= m.n {
+			m.augmentBlossom(t, m.endVtx[p^1])
+		}
+
+		m.mate[m.endVtx[p]] = p ^ 1
+		m.mate[m.endVtx[p^1]] = p
+	}
+
+	// rotating the cycle, so that the new base comes first
+	m.children[b] = append(append([]int{}, children[i:]...), children[:i]...)
+	m.links[b] = append(append([]int{}, links[i:]...), links[:i]...)
+	m.base[b] = m.base[m.children[b][0]]
+}
+
+// augmentMatching augments the matching along the path through the edge k, between two S-vertices.
+func (m *mwMatcher) augmentMatching(k int) {
+	ends := [2][2]int{
+		{m.edges[k].u, 2*k + 1},
+		{m.edges[k].v, 2 * k},
+	}
+
+	for _, end := range ends {
+		s, p := end[0], end[1]
+
+		for {
+			bs := m.top[s]
+
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+
+			m.mate[s] = p
+
+			if m.labelEnd[bs] == -1 {
+				// reached a free vertex: stop
+				break
+			}
+
+			t := m.endVtx[m.labelEnd[bs]]
+			bt := m.top[t]
+
+			s = m.endVtx[m.labelEnd[bt]]
+			j := m.endVtx[m.labelEnd[bt]^1]
+
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+
+			m.mate[j] = m.labelEnd[bt]
+
+			p = m.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+// grow scans the vertices in the queue, growing the alternating trees, until the matching is augmented.
+func (m *mwMatcher) grow() bool {
+	for len(m.queue) != 0 {
+		v := m.queue[len(m.queue)-1]
+		m.queue = m.queue[:len(m.queue)-1]
+
+		for _, p := range m.remote[v] {
+			k := p / 2
+			w := m.endVtx[p]
+
+			if m.top[v] == m.top[w] {
+				continue
+			}
+
+			var slack float64
+
+			if !m.tight[k] {
+				slack = m.slack(k)
+				m.tight[k] = slack <= 0
+			}
+
+			bw := m.top[w]
+
+			if !m.tight[k] {
+				// keeping track of the least-slack edges,
+				// to calculate the next dual update
+				switch {
+				case m.label[bw] == mwOuter:
+					bv := m.top[v]
+
+					if m.bestEdge[bv] == -1 || slack < m.slack(m.bestEdge[bv]) {
+						m.bestEdge[bv] = k
+					}
+
+				case m.label[w] == mwFree:
+					if m.bestEdge[w] == -1 || slack < m.slack(m.bestEdge[w]) {
+						m.bestEdge[w] = k
+					}
+				}
+
+				continue
+			}
+
+			switch {
+			case m.label[bw] == mwFree:
+				m.assignLabel(w, mwInner, p^1)
+
+			case m.label[bw] == mwOuter:
+				base := m.scanBlossom(v, w)
+
+				if base == -1 {
+					m.augmentMatching(k)
+					return true
+				}
+
+				m.addBlossom(base, k)
+
+			case m.label[w] == mwFree:
+				// w is inside a T-blossom, but was not reached yet
+				m.label[w] = mwInner
+				m.labelEnd[w] = p ^ 1
+			}
+		}
+	}
+
+	return false
+}
+
+// minVertexDual calculates the smallest dual variable among the vertices.
+func (m *mwMatcher) minVertexDual() float64 {
+	res := m.dual[0]
+
+	for v := 1; v < m.n; v++ {
+		if m.dual[v] < res {
+			res = m.dual[v]
+		}
+	}
+
+	return res
+}
+
+/*
+delta calculates the largest update of the dual variables that keeps them feasible, returning the kind
+of update, along with the edge that becomes tight or the blossom that can be expanded, depending on it.
+*/
+func (m *mwMatcher) delta(maxCard bool) (kind int, delta float64, edge int, blossom int) {
+	edge, blossom = -1, -1
+
+	if !maxCard {
+		kind, delta = mwOptimal, m.minVertexDual()
+	}
+
+	for v := 0; v < m.n; v++ {
+		if m.label[m.top[v]] != mwFree || m.bestEdge[v] == -1 {
+			continue
+		}
+
+		if d := m.slack(m.bestEdge[v]); kind == 0 || d < delta {
+			kind, delta, edge = mwFreeEdge, d, m.bestEdge[v]
+		}
+	}
+
+	for b := 0; b < 2*m.n; b++ {
+		if m.parent[b] != -1 || m.label[b] != mwOuter || m.bestEdge[b] == -1 {
+			continue
+		}
+
+		if d := m.slack(m.bestEdge[b]) / 2; kind == 0 || d < delta {
+			kind, delta, edge = mwOuterEdge, d, m.bestEdge[b]
+		}
+	}
+
+	for b := m.n; b < 2*m.n; b++ {
+		if m.base[b] == -1 || m.parent[b] != -1 || m.label[b] != mwInner {
+			continue
+		}
+
+		if kind == 0 || m.dual[b] < delta {
+			kind, delta, blossom = mwExpand, m.dual[b], b
+		}
+	}
+
+	if kind == 0 {
+		// no further improvement is possible with maximum cardinality,
+		// but a final update makes the optimum verifiable
+		kind, delta = mwOptimal, m.minVertexDual()
+
+		if delta < 0 {
+			delta = 0
+		}
+	}
+
+	return kind, delta, edge, blossom
+}
+
+// update applies an update of delta to the dual variables of labeled vertices and top-level blossoms.
+func (m *mwMatcher) update(delta float64) {
+	for v := 0; v < m.n; v++ {
+		switch m.label[m.top[v]] {
+		case mwOuter:
+			m.dual[v] -= delta
+		case mwInner:
+			m.dual[v] += delta
+		}
+	}
+
+	for b := m.n; b < 2*m.n; b++ {
+		if m.base[b] == -1 || m.parent[b] != -1 {
+			continue
+		}
+
+		switch m.label[b] {
+		case mwOuter:
+			m.dual[b] += delta
+		case mwInner:
+			m.dual[b] -= delta
+		}
+	}
+}
+
+// stage runs a stage of the algorithm, which either augments the matching, or proves that it is optimal.
+func (m *mwMatcher) stage(maxCard bool) bool {
+	for b := 0; b < 2*m.n; b++ {
+		m.label[b] = mwFree
+		m.bestEdge[b] = -1
+
+		if b >= m.n {
+			m.bestEdges[b] = nil
+		}
+	}
+
+	for k := range m.tight {
+		m.tight[k] = false
+	}
+
+	m.queue = m.queue[:0]
+
+	for v := 0; v < m.n; v++ {
+		if m.mate[v] == -1 && m.label[m.top[v]] == mwFree {
+			m.assignLabel(v, mwOuter, -1)
+		}
+	}
+
+	for !m.grow() {
+		kind, delta, edge, blossom := m.delta(maxCard)
+
+		m.update(delta)
+
+		switch kind {
+		case mwOptimal:
+			return false
+
+		case mwFreeEdge:
+			m.tight[edge] = true
+
+			// the endpoint of the edge that is an S-vertex
+			i := m.edges[edge].u
+
+			if m.label[m.top[i]] == mwFree {
+				i = m.edges[edge].v
+			}
+
+			m.queue = append(m.queue, i)
+
+		case mwOuterEdge:
+			m.tight[edge] = true
+			m.queue = append(m.queue, m.edges[edge].u)
+
+		case mwExpand:
+			m.expandBlossom(blossom, false)
+		}
+	}
+
+	// S-blossoms with a zero dual are
+	// no longer needed after the stage
+	for b := m.n; b < 2*m.n; b++ {
+		if m.parent[b] == -1 && m.base[b] != -1 && m.label[b] == mwOuter && m.dual[b] == 0 {
+			m.expandBlossom(b, true)
+		}
+	}
+
+	return true
+}
+
+// indexOf finds the position of x in s, or -1 if x is not in s.
+func indexOf(s []int, x int) int {
+	for i := range s {
+		if s[i] == x {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func reverse(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+/*
+maxWeightMatching implements Edmonds' blossom algorithm for finding a maximum-weight matching
+in a general undirected graph with n vertices, using the primal-dual method. If maxCard is true,
+only matchings of maximum cardinality are considered. The result holds, for every vertex,
+the vertex that it is matched to, or -1 if unmatched.
+
+Each stage grows alternating trees out of the free vertices, shrinking odd cycles into blossoms,
+until either an augmenting path is found, or the dual variables prove that the matching is optimal.
+The implementation is based on the one by Joris van Rantwijk, which follows "Efficient Algorithms
+for Finding Maximum Matching in Graphs" (Zvi Galil, 1986).
+
+Expectations:
+	- Every edge is between two different vertices in [0, n).
+
+Complexity:
+	- Time:  O(V³)
+	- Space: Θ(V + E)
+*/
+func maxWeightMatching(n int, edges []mwEdge, maxCard bool) []int {
+	res := make([]int, n)
+
+	for i := range res {
+		res[i] = -1
+	}
+
+	if len(edges) == 0 {
+		return res
+	}
+
+	m := newMWMatcher(n, edges)
+
+	for stage := 0; stage < n; stage++ {
+		if !m.stage(maxCard) {
+			break
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if m.mate[v] != -1 {
+			res[v] = m.endVtx[m.mate[v]]
+		}
+	}
+
+	return res
+}
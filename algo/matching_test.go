@@ -0,0 +1,121 @@
+package algo
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// bruteMatch finds the weight of a maximum-weight matching by trying every matching.
+func bruteMatch(n int, w [][]float64, perfect bool) float64 {
+	memo := map[int]float64{}
+
+	var best func(int) float64
+
+	best = func(mask int) float64 {
+		if res, ok := memo[mask]; ok {
+			return res
+		}
+
+		i := 0
+
+		for i < n && mask&(1<<i) != 0 {
+			i++
+		}
+
+		if i == n {
+			return 0
+		}
+
+		res := math.Inf(-1)
+
+		if !perfect {
+			res = best(mask | 1<<i)
+		}
+
+		for j := i + 1; j < n; j++ {
+			if mask&(1<<j) != 0 || math.IsNaN(w[i][j]) {
+				continue
+			}
+
+			res = math.Max(res, best(mask|1<<i|1<<j)+w[i][j])
+		}
+
+		memo[mask] = res
+
+		return res
+	}
+
+	return best(0)
+}
+
+func TestMaxWeightMatching(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 300; i++ {
+		n := 2 + r.Intn(11)
+		perfect := i%2 == 0
+
+		if perfect && n%2 != 0 {
+			n++
+		}
+
+		w := make([][]float64, n)
+
+		for u := range w {
+			w[u] = make([]float64, n)
+
+			for v := range w[u] {
+				w[u][v] = math.NaN()
+			}
+		}
+
+		edges := []mwEdge{}
+
+		for u := 0; u < n; u++ {
+			for v := u + 1; v < n; v++ {
+				if !perfect && r.Float64() < 0.4 {
+					continue
+				}
+
+				var wt float64
+
+				// integer weights lead to lots of ties
+				if i%4 < 2 {
+					wt = float64(r.Intn(20))
+				} else {
+					wt = r.Float64() * 100
+				}
+
+				w[u][v], w[v][u] = wt, wt
+				edges = append(edges, mwEdge{u, v, wt})
+			}
+		}
+
+		mate := maxWeightMatching(n, edges, perfect)
+
+		got := 0.0
+		count := 0
+
+		for u, v := range mate {
+			if v == -1 {
+				continue
+			}
+
+			ut.Equal(t, u, mate[v])
+
+			if u < v {
+				got += w[u][v]
+				count++
+			}
+		}
+
+		if perfect {
+			ut.Equal(t, n, 2*count)
+		}
+
+		ut.True(t, math.Abs(got-bruteMatch(n, w, perfect)) < 1e-6)
+	}
+}
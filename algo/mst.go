@@ -14,7 +14,7 @@ spanning tree of an undirected graph with weighted edges. If such an
 algorithm is called on a directed graph, then ds.ErrUndefOp error
 is returned.
 */
-type MSTAlgo func(*ds.G) (MST, error)
+type MSTAlgo func(ds.Graph) (MST, error)
 
 /*
 An MST holds the edges of a minimum spanning tree
@@ -34,7 +34,7 @@ type primVtx struct {
 	wt float64
 
 	// edge holds the best edge found so far that can connect the vertex to the MST.
-	edge *ds.GE
+	edge ds.GE
 
 	// in tells if the vertex is still in the heap.
 	in bool
@@ -105,7 +105,7 @@ Complexity:
 	- Time:  O(E log V)
 	- Space: Θ(V).
 */
-func MSTPrim(g *ds.G) (MST, error) {
+func MSTPrim(g ds.Graph) (MST, error) {
 	if g.Directed() {
 		return nil, ds.ErrDirected
 	}
@@ -113,7 +113,7 @@ func MSTPrim(g *ds.G) (MST, error) {
 	vtxHeap := make(primVtxHeap, g.VertexCount())
 	att := make([]primVtx, g.VertexCount())
 
-	for v := range g.V {
+	for v := range att {
 		var wt float64
 
 		// source
@@ -142,26 +142,26 @@ func MSTPrim(g *ds.G) (MST, error) {
 			return nil, ds.ErrDisconnected
 		}
 
-		if vtx.edge != nil {
-			mst = append(mst, *vtx.edge)
+		// the source is the only vertex that
+		// joins the MST without an edge
+		if vtx.id != 0 {
+			mst = append(mst, vtx.edge)
 		}
 
-		for e := range g.V[vtx.id].E {
-			edge := &g.V[vtx.id].E[e]
-
+		g.ForEachEdge(vtx.id, func(edge ds.GE) {
 			if !att[edge.Dst].in {
-				continue
+				return
 			}
 
 			if edge.Wt >= att[edge.Dst].wt {
-				continue
+				return
 			}
 
 			att[edge.Dst].edge = edge
 			att[edge.Dst].wt = edge.Wt
 
 			heap.Fix(&vtxHeap, att[edge.Dst].index)
-		}
+		})
 	}
 
 	return mst, nil
@@ -187,20 +187,21 @@ Complexity:
 	- Time:  O(E log V)
 	- Space: Θ(V + E).
 */
-func MSTKruskal(g *ds.G) (MST, error) {
+func MSTKruskal(g ds.Graph) (MST, error) {
 	if g.Directed() {
 		return nil, ds.ErrDirected
 	}
 
-	edges := make([]ds.GE, g.EdgeCount())
+	edges := make([]ds.GE, 0, g.EdgeCount())
 
-	// By iterating over G.V and adding edges using their original
-	// insertion order, we can guarantee that every call of the
-	// algorithm on the same graph always yields the same MST,
-	// since multiple MSTs might exist for the same graph.
-	for i, eIdx := 0, 0; i < len(g.V); i++ {
-		copy(edges[eIdx:], g.V[i].E)
-		eIdx += len(g.V[i].E)
+	// By iterating over every vertex and adding edges using the
+	// order in which the graph lists them, we can guarantee that
+	// every call of the algorithm on the same graph always yields
+	// the same MST, since multiple MSTs might exist for the same graph.
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e ds.GE) {
+			edges = append(edges, e)
+		})
 	}
 
 	// By using a stable sorting algorithm to sort the sequence
@@ -211,7 +212,7 @@ func MSTKruskal(g *ds.G) (MST, error) {
 
 	d := ds.NewDSet[int]()
 
-	for v := range g.V {
+	for v := 0; v < g.VertexCount(); v++ {
 		d.MakeSet(v)
 	}
 
@@ -5,28 +5,16 @@ import (
 	"testing"
 
 	"github.com/vc-souza/gga/ds"
-	ut "github.com/vc-souza/gga/internal/testutils"
+	"github.com/vc-souza/gga/gen"
 )
 
 func mstBenchGen(n int) *ds.G {
-	g := ds.NewGraph()
+	g, _ := gen.Complete(n)
 
-	for i := 0; i < n; i++ {
-		v := ut.BenchItem(i)
-		g.AddVertex(&v)
-	}
-
-	for i := 0; i < n; i++ {
-		for j := 0; j < n; j++ {
-			if i == j {
-				continue
-			}
-
-			g.AddEdge(
-				g.V[i].Item,
-				g.V[j].Item,
-				float64(i+j),
-			)
+	// the edge between the vertices i and j weighs i + j
+	for v := range g.V {
+		for e := range g.V[v].E {
+			g.V[v].E[e].Wt = float64(v + g.V[v].E[e].Dst)
 		}
 	}
 
@@ -101,3 +101,68 @@ func TestMSTPrim_disconnected(t *testing.T) {
 	ut.NotNil(t, err)
 	ut.True(t, errors.Is(err, ds.ErrDisconnected))
 }
+
+func TestMST_multigraph(t *testing.T) {
+	for _, tc := range mstCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g := ds.NewGraphWith(ds.Multigraph())
+
+			a, b, c := ds.Text("a"), ds.Text("b"), ds.Text("c")
+
+			g.AddVertex(a)
+			g.AddVertex(b)
+			g.AddVertex(c)
+
+			for _, e := range []struct {
+				src, dst ds.Item
+				wt       float64
+			}{
+				{a, b, 5},
+				{a, b, 1},
+				{b, c, 3},
+				{b, c, 7},
+				{a, c, 4},
+				{a, b, 2},
+			} {
+				g.AddEdge(e.src, e.dst, e.wt)
+			}
+
+			mst, err := tc.algo(g)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 2, len(mst))
+
+			total := 0.0
+
+			for _, e := range mst {
+				total += e.Wt
+
+				// the edge is the exact parallel edge that was picked
+				ut.Equal(t, e.Wt, g.V[e.Src].E[e.Index].Wt)
+			}
+
+			ut.Equal(t, 4.0, total)
+		})
+	}
+}
+
+func TestBFS_multigraph(t *testing.T) {
+	g := ds.NewDigraphWith(ds.Multigraph())
+
+	a, b, c := ds.Text("a"), ds.Text("b"), ds.Text("c")
+
+	g.AddVertex(a)
+	g.AddVertex(b)
+	g.AddVertex(c)
+
+	g.AddEdge(a, b, 0)
+	g.AddEdge(a, b, 0)
+	g.AddEdge(b, c, 0)
+	g.AddEdge(b, c, 0)
+
+	tree, err := BFS(g, 0)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1.0, tree[1].Distance)
+	ut.Equal(t, 2.0, tree[2].Distance)
+}
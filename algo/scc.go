@@ -9,7 +9,7 @@ SCCAlgo describes the signature of an algorithm that can discover all
 strongly connected components in a directed graph. If such an algorithm is
 called on an undirected graph, the ds.ErrUndefOp error is returned.
 */
-type SCCAlgo func(*ds.G) ([]SCC, error)
+type SCCAlgo func(ds.Graph) ([]SCC, error)
 
 // An SCC holds the vertices in a strongly connected component of a directed graph.
 type SCC []int
@@ -43,7 +43,7 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func SCCKosaraju(g *ds.G) ([]SCC, error) {
+func SCCKosaraju(g ds.Graph) ([]SCC, error) {
 	if g.Undirected() {
 		return nil, ds.ErrUndirected
 	}
@@ -62,7 +62,7 @@ func SCCKosaraju(g *ds.G) ([]SCC, error) {
 	}
 
 	// Θ(V + E)
-	tg, err := ds.Transpose(g)
+	tg, err := ds.TransposeCSR(g)
 
 	if err != nil {
 		return nil, err
@@ -71,11 +71,11 @@ func SCCKosaraju(g *ds.G) ([]SCC, error) {
 	visit = func(v int) {
 		visited[v] = true
 
-		for _, e := range tg.V[v].E {
+		tg.ForEachEdge(v, func(e ds.GE) {
 			if !visited[e.Dst] {
 				visit(e.Dst)
 			}
-		}
+		})
 
 		*scc = append(*scc, v)
 	}
@@ -146,7 +146,7 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func SCCTarjan(g *ds.G) ([]SCC, error) {
+func SCCTarjan(g ds.Graph) ([]SCC, error) {
 	if g.Undirected() {
 		return nil, ds.ErrUndirected
 	}
@@ -170,7 +170,7 @@ func SCCTarjan(g *ds.G) ([]SCC, error) {
 
 		i++
 
-		for _, e := range g.V[v].E {
+		g.ForEachEdge(v, func(e ds.GE) {
 			if att[e.Dst].index == 0 {
 				visit(e.Dst)
 
@@ -187,7 +187,7 @@ func SCCTarjan(g *ds.G) ([]SCC, error) {
 					att[e.Dst].index,
 				)
 			}
-		}
+		})
 
 		// root of an SCC, otherwise do not pop anything
 		if att[v].lowIndex == att[v].index {
@@ -211,7 +211,7 @@ func SCCTarjan(g *ds.G) ([]SCC, error) {
 		}
 	}
 
-	for v := range g.V {
+	for v := range att {
 		if att[v].index == 0 {
 			visit(v)
 		}
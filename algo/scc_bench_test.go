@@ -5,26 +5,11 @@ import (
 	"testing"
 
 	"github.com/vc-souza/gga/ds"
-	ut "github.com/vc-souza/gga/internal/testutils"
+	"github.com/vc-souza/gga/gen"
 )
 
 func sccBenchGen(n int) *ds.G {
-	g := ds.NewDigraph()
-
-	for i := 0; i < n; i++ {
-		v := ut.BenchItem(i)
-		g.AddVertex(&v)
-	}
-
-	for i := 0; i < n; i++ {
-		for j := 0; j < n; j++ {
-			g.AddEdge(
-				g.V[i].Item,
-				g.V[j].Item,
-				0,
-			)
-		}
-	}
+	g, _ := gen.Complete(n, gen.Directed())
 
 	return g
 }
@@ -48,5 +33,23 @@ func BenchmarkSCC(b *testing.B) {
 				SCCTarjan(g)
 			}
 		})
+
+		b.Run(fmt.Sprintf("kosaraju-csr-%d", size), func(b *testing.B) {
+			g := ds.NewCSR(sccBenchGen(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				SCCKosaraju(g)
+			}
+		})
+
+		b.Run(fmt.Sprintf("tarjan-csr-%d", size), func(b *testing.B) {
+			g := ds.NewCSR(sccBenchGen(size))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				SCCTarjan(g)
+			}
+		})
 	}
 }
@@ -0,0 +1,159 @@
+package algo
+
+import (
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+TriangleStats holds the triangle counts of an undirected graph,
+along with the clustering metrics that can be derived from them.
+*/
+type TriangleStats struct {
+	// Vertex holds the number of triangles that each vertex is a part of.
+	Vertex []int
+
+	// Total is the number of triangles in the graph.
+	Total int
+
+	/*
+		Clustering holds the local clustering coefficient of each vertex: the fraction of pairs
+		of neighbors of the vertex that are also adjacent to each other. Vertices with fewer
+		than two neighbors have a local clustering coefficient of 0.
+	*/
+	Clustering []float64
+
+	// AvgClustering is the average of the local clustering coefficients of all vertices.
+	AvgClustering float64
+
+	/*
+		Transitivity is the global clustering coefficient of the graph: the fraction
+		of connected triples of vertices (paths of length 2) that are closed by
+		a third edge, forming a triangle.
+	*/
+	Transitivity float64
+}
+
+/*
+Triangles implements the forward algorithm for counting the triangles of an undirected graph,
+also calculating the clustering coefficients of its vertices and the transitivity of the graph.
+
+Checking whether an edge exists in a graph backed by adjacency lists is an O(E) operation,
+so instead of testing every pair of neighbors of a vertex for adjacency, vertices are ranked
+by degree (ties broken by index), and each edge is oriented from its lower-ranked vertex to its
+higher-ranked one. A vertex can then have at most O(√E) higher-ranked neighbors, and every
+triangle is found exactly once, from its lowest-ranked vertex u: the higher-ranked neighbors of u
+are marked, and for every higher-ranked neighbor v of u, any higher-ranked neighbor of v that is
+also marked closes a triangle.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected.
+
+Complexity:
+	- Time:  O(E √E)
+	- Space: Θ(V + E)
+*/
+func Triangles(g ds.Graph) (TriangleStats, error) {
+	if g.Directed() {
+		return TriangleStats{}, ds.ErrDirected
+	}
+
+	count := g.VertexCount()
+
+	// mark is shared by every step that needs to flag vertices,
+	// with a different stamp being used each time, so that
+	// the slice never needs to be cleared between uses
+	mark := make([]int, count)
+	stamp := 0
+
+	// using the number of distinct neighbors as the
+	// degree, so that repeated edges are not counted
+	deg := make([]int, count)
+
+	for v := range deg {
+		stamp++
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			if mark[e.Dst] == stamp {
+				return
+			}
+
+			mark[e.Dst] = stamp
+			deg[v]++
+		})
+	}
+
+	higher := func(u, v int) bool {
+		if deg[u] != deg[v] {
+			return deg[u] < deg[v]
+		}
+
+		return u < v
+	}
+
+	fwd := make([][]int, count)
+
+	for v := range fwd {
+		stamp++
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			if mark[e.Dst] == stamp || !higher(v, e.Dst) {
+				return
+			}
+
+			mark[e.Dst] = stamp
+			fwd[v] = append(fwd[v], e.Dst)
+		})
+	}
+
+	res := TriangleStats{
+		Vertex:     make([]int, count),
+		Clustering: make([]float64, count),
+	}
+
+	for u := range fwd {
+		stamp++
+
+		for _, v := range fwd[u] {
+			mark[v] = stamp
+		}
+
+		for _, v := range fwd[u] {
+			for _, w := range fwd[v] {
+				if mark[w] != stamp {
+					continue
+				}
+
+				res.Vertex[u]++
+				res.Vertex[v]++
+				res.Vertex[w]++
+				res.Total++
+			}
+		}
+	}
+
+	triples := 0
+
+	for v := range deg {
+		pairs := deg[v] * (deg[v] - 1) / 2
+
+		if pairs == 0 {
+			continue
+		}
+
+		triples += pairs
+
+		res.Clustering[v] = float64(res.Vertex[v]) / float64(pairs)
+		res.AvgClustering += res.Clustering[v]
+	}
+
+	if count != 0 {
+		res.AvgClustering /= float64(count)
+	}
+
+	if triples != 0 {
+		res.Transitivity = 3 * float64(res.Total) / float64(triples)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,81 @@
+package algo
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestTriangles_directed(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	_, err = Triangles(g)
+
+	ut.NotNil(t, err)
+	ut.True(t, errors.Is(err, ds.ErrUndefOp))
+}
+
+func TestTriangles_undirected(t *testing.T) {
+	g, idx, err := ds.Parse(`
+	graph
+	a#b,c,d,e
+	b#a,c,d,e
+	c#a,b,d
+	d#a,b,c
+	e#a,b,f
+	f#e
+	g#
+	`)
+
+	ut.Nil(t, err)
+
+	expect := map[string]struct {
+		count int
+		coef  float64
+	}{
+		"a": {4, 4.0 / 6.0},
+		"b": {4, 4.0 / 6.0},
+		"c": {3, 1},
+		"d": {3, 1},
+		"e": {1, 1.0 / 3.0},
+		"f": {0, 0},
+		"g": {0, 0},
+	}
+
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) < 1e-9
+	}
+
+	stats, err := Triangles(g)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 5, stats.Total)
+
+	for s, exp := range expect {
+		ut.Equal(t, exp.count, stats.Vertex[idx(s)])
+		ut.True(t, near(exp.coef, stats.Clustering[idx(s)]))
+	}
+
+	ut.True(t, near(11.0/21.0, stats.AvgClustering))
+	ut.True(t, near(15.0/21.0, stats.Transitivity))
+}
+
+func TestTriangles_disconnected(t *testing.T) {
+	g, _, err := ds.Parse(ut.UUGDisc)
+
+	ut.Nil(t, err)
+
+	stats, err := Triangles(g)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 1, stats.Total)
+	ut.Equal(t, 3, stats.Vertex[0]+stats.Vertex[1]+stats.Vertex[2])
+	ut.True(t, stats.Transitivity > 0)
+}
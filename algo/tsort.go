@@ -21,7 +21,7 @@ Complexity:
 	- Time:  Θ(V + E)
 	- Space: Θ(V)
 */
-func TSort(g *ds.G) ([]int, error) {
+func TSort(g ds.Graph) ([]int, error) {
 	if g.Undirected() {
 		return nil, ds.ErrUndirected
 	}
@@ -37,17 +37,17 @@ func TSort(g *ds.G) ([]int, error) {
 	visit = func(v int) {
 		visited[v] = true
 
-		for _, e := range g.V[v].E {
+		g.ForEachEdge(v, func(e ds.GE) {
 			if !visited[e.Dst] {
 				visit(e.Dst)
 			}
-		}
+		})
 
 		ord[ordIdx] = v
 		ordIdx--
 	}
 
-	for v := range g.V {
+	for v := range visited {
 		if visited[v] {
 			continue
 		}
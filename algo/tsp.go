@@ -0,0 +1,475 @@
+package algo
+
+import (
+	"math"
+
+	"github.com/vc-souza/gga/ds"
+)
+
+// tspEps is the smallest improvement in the cost of a tour that is considered by the local search heuristics.
+const tspEps = 1e-9
+
+/*
+TSPAlgo describes the signature of an algorithm that can build a tour for the
+Traveling Salesman Problem, returning the tour along with its cost.
+*/
+type TSPAlgo func(ds.Graph) (Tour, float64, error)
+
+/*
+TSPImprover describes the signature of a local search heuristic that, given a tour
+for the Traveling Salesman Problem, tries to build a tour of smaller cost, returning
+the resulting tour along with its cost.
+*/
+type TSPImprover func(ds.Graph, Tour) (Tour, float64, error)
+
+/*
+tspMatrix calculates the weight matrix of a graph that is suitable for the
+approximate TSP algorithms: undirected and complete, with at least 3 vertices.
+*/
+func tspMatrix(g ds.Graph) ([][]float64, error) {
+	if g.Directed() {
+		return nil, ds.ErrDirected
+	}
+
+	if g.VertexCount() < 3 {
+		return nil, ds.ErrNoHamCycle
+	}
+
+	w := weightMatrix(g)
+
+	for v := range w {
+		for u := range w[v] {
+			if u != v && math.IsInf(w[v][u], 1) {
+				return nil, ds.ErrIncomplete
+			}
+		}
+	}
+
+	return w, nil
+}
+
+// checkTour checks whether or not a tour visits every one of the n vertices of a graph exactly once.
+func checkTour(n int, t Tour) error {
+	if len(t) != n {
+		return ds.ErrInvTour
+	}
+
+	seen := make([]bool, n)
+
+	for _, v := range t {
+		if v < 0 || v >= n || seen[v] {
+			return ds.ErrInvTour
+		}
+
+		seen[v] = true
+	}
+
+	return nil
+}
+
+// tourCost calculates the cost of a tour, including the edge that closes it.
+func tourCost(w [][]float64, t Tour) float64 {
+	cost := 0.0
+
+	for i := range t {
+		cost += w[t[i]][t[(i+1)%len(t)]]
+	}
+
+	return cost
+}
+
+// shortcut builds a tour from a walk that visits every vertex, by skipping vertices that were already visited.
+func shortcut(walk []int, n int) Tour {
+	visited := make([]bool, n)
+	tour := make(Tour, 0, n)
+
+	for _, v := range walk {
+		if visited[v] {
+			continue
+		}
+
+		visited[v] = true
+		tour = append(tour, v)
+	}
+
+	return tour
+}
+
+/*
+TSPDoubleTree implements the double-tree algorithm, a 2-approximation for the metric Traveling Salesman
+Problem: in a complete undirected graph whose edge weights satisfy the triangle inequality, the cost
+of the tour it builds is at most twice the cost of an optimal tour.
+
+A minimum spanning tree is built (MSTPrim), and every one of its edges is doubled, yielding a closed
+walk that visits every vertex and costs exactly twice as much as the MST, which in turn costs less
+than an optimal tour (removing an edge from a tour yields a spanning tree). The walk is then turned
+into a tour by skipping vertices that were already visited, which due to the triangle inequality
+never increases its cost. Skipping vertices in the walk around the doubled tree is the same as
+visiting the vertices of the tree in preorder, which is what this implementation does.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected and complete.
+
+Complexity:
+	- Time:  O(V² + E log V)
+	- Space: Θ(V²)
+*/
+func TSPDoubleTree(g ds.Graph) (Tour, float64, error) {
+	w, err := tspMatrix(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mst, err := MSTPrim(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	adj := make([][]int, g.VertexCount())
+
+	for _, e := range mst {
+		adj[e.Src] = append(adj[e.Src], e.Dst)
+		adj[e.Dst] = append(adj[e.Dst], e.Src)
+	}
+
+	var visit func(int, int)
+
+	tour := make(Tour, 0, g.VertexCount())
+
+	visit = func(v, parent int) {
+		tour = append(tour, v)
+
+		for _, u := range adj[v] {
+			if u != parent {
+				visit(u, v)
+			}
+		}
+	}
+
+	visit(0, -1)
+
+	return tour, tourCost(w, tour), nil
+}
+
+/*
+eulerCircuit implements Hierholzer's algorithm for finding an Eulerian circuit in a connected
+undirected multigraph whose vertices all have even degree, starting from the vertex 0.
+*/
+func eulerCircuit(n int, edges [][2]int) []int {
+	type half struct{ to, id int }
+
+	adj := make([][]half, n)
+
+	for id, e := range edges {
+		adj[e[0]] = append(adj[e[0]], half{e[1], id})
+		adj[e[1]] = append(adj[e[1]], half{e[0], id})
+	}
+
+	used := make([]bool, len(edges))
+	next := make([]int, n)
+
+	circuit := make([]int, 0, len(edges)+1)
+	stack := ds.NewStack[int]()
+
+	stack.Push(0)
+
+	for !stack.Empty() {
+		v, _ := stack.Peek()
+
+		// skipping edges that were already traversed from the other side
+		for next[v] < len(adj[v]) && used[adj[v][next[v]].id] {
+			next[v]++
+		}
+
+		if next[v] == len(adj[v]) {
+			stack.Pop()
+			circuit = append(circuit, v)
+			continue
+		}
+
+		h := adj[v][next[v]]
+		used[h.id] = true
+
+		stack.Push(h.to)
+	}
+
+	return circuit
+}
+
+/*
+TSPChristofides implements the Christofides algorithm, a 3/2-approximation for the metric Traveling
+Salesman Problem: in a complete undirected graph whose edge weights satisfy the triangle inequality,
+the cost of the tour it builds is at most 3/2 of the cost of an optimal tour.
+
+A minimum spanning tree is built (MSTPrim), and the set O of its vertices with odd degree - which always
+has an even number of vertices - is found. A minimum-weight perfect matching of the vertices in O is
+then calculated, using Edmonds' blossom algorithm, and its edges are added to the ones in the MST,
+resulting in a connected multigraph where every vertex has even degree. An Eulerian circuit of
+this multigraph is found (Hierholzer's algorithm), and turned into a tour by skipping vertices that
+were already visited, which due to the triangle inequality never increases its cost.
+
+The MST costs less than an optimal tour, and the matching costs at most half of it (an optimal tour,
+with the vertices that are not in O skipped, can be split into two perfect matchings of O), which
+is where the 3/2 bound comes from.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected and complete.
+
+Complexity:
+	- Time:  O(V³)
+	- Space: Θ(V²)
+*/
+func TSPChristofides(g ds.Graph) (Tour, float64, error) {
+	w, err := tspMatrix(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	mst, err := MSTPrim(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	n := g.VertexCount()
+	deg := make([]int, n)
+
+	edges := make([][2]int, 0, n+n/2)
+
+	for _, e := range mst {
+		deg[e.Src]++
+		deg[e.Dst]++
+
+		edges = append(edges, [2]int{e.Src, e.Dst})
+	}
+
+	odd := []int{}
+
+	for v := range deg {
+		if deg[v]%2 != 0 {
+			odd = append(odd, v)
+		}
+	}
+
+	maxWt := 0.0
+
+	for _, u := range odd {
+		for _, v := range odd {
+			if u != v && w[u][v] > maxWt {
+				maxWt = w[u][v]
+			}
+		}
+	}
+
+	// a maximum-weight matching among the matchings of maximum
+	// cardinality (perfect, in a complete graph with an even
+	// number of vertices) minimizes the sum of the original
+	// weights, once they are subtracted from a constant
+	pairs := []mwEdge{}
+
+	for i := range odd {
+		for j := i + 1; j < len(odd); j++ {
+			pairs = append(pairs, mwEdge{i, j, maxWt + 1 - w[odd[i]][odd[j]]})
+		}
+	}
+
+	mate := maxWeightMatching(len(odd), pairs, true)
+
+	for i, j := range mate {
+		if i < j {
+			edges = append(edges, [2]int{odd[i], odd[j]})
+		}
+	}
+
+	tour := shortcut(eulerCircuit(n, edges), n)
+
+	return tour, tourCost(w, tour), nil
+}
+
+// twoOptPass applies the first improving 2-opt move found, if any.
+func twoOptPass(w [][]float64, t Tour) bool {
+	n := len(t)
+
+	for i := 0; i < n-2; i++ {
+		for j := i + 2; j < n; j++ {
+			// (t[n-1], t[0]) and (t[0], t[1]) share a vertex
+			if i == 0 && j == n-1 {
+				continue
+			}
+
+			a, b := t[i], t[i+1]
+			c, d := t[j], t[(j+1)%n]
+
+			delta := w[a][c] + w[b][d] - w[a][b] - w[c][d]
+
+			if delta >= -tspEps {
+				continue
+			}
+
+			reverse(t[i+1 : j+1])
+
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+TSP2Opt implements the 2-opt local search heuristic for the Traveling Salesman Problem.
+
+Given a tour, 2-opt looks for a pair of edges (a, b) and (c, d) in the tour that can be replaced by the
+edges (a, c) and (b, d) - which is the same as reversing the part of the tour that goes from b to c -
+in a way that reduces the cost of the tour. The first improving move found is applied, and the search
+starts over, until no such pair of edges exists: the tour is then said to be 2-optimal.
+
+The first vertex of the tour is never moved, so the resulting tour starts at the same vertex. If the tour
+does not visit every vertex of the graph exactly once, ErrInvTour is returned.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected and complete.
+
+Complexity:
+	- Time:  O(V²) per improving move.
+	- Space: Θ(V²)
+*/
+func TSP2Opt(g ds.Graph, t Tour) (Tour, float64, error) {
+	w, err := tspMatrix(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = checkTour(len(w), t); err != nil {
+		return nil, 0, err
+	}
+
+	res := make(Tour, len(t))
+	copy(res, t)
+
+	for twoOptPass(w, res) {
+	}
+
+	return res, tourCost(w, res), nil
+}
+
+// orOptPass applies the first improving Or-opt move found, if any.
+func orOptPass(w [][]float64, t Tour) bool {
+	n := len(t)
+
+	for size := 1; size <= 3 && n-size >= 2; size++ {
+		// the first vertex of the tour is never moved
+		for i := 1; i+size <= n; i++ {
+			prev, next := t[i-1], t[(i+size)%n]
+			first, last := t[i], t[i+size-1]
+
+			gain := w[prev][first] + w[last][next] - w[prev][next]
+
+			rest := make(Tour, 0, n-size)
+			rest = append(rest, t[:i]...)
+			rest = append(rest, t[i+size:]...)
+
+			for p := range rest {
+				x, y := rest[p], rest[(p+1)%len(rest)]
+
+				// the original position of the segment
+				if x == prev && y == next {
+					continue
+				}
+
+				fwd := w[x][first] + w[last][y] - w[x][y]
+				rev := w[x][last] + w[first][y] - w[x][y]
+
+				if fwd >= gain-tspEps && rev >= gain-tspEps {
+					continue
+				}
+
+				seg := make(Tour, size)
+				copy(seg, t[i:i+size])
+
+				if rev < fwd {
+					reverse(seg)
+				}
+
+				copy(t, rest[:p+1])
+				copy(t[p+1:], seg)
+				copy(t[p+1+size:], rest[p+1:])
+
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+/*
+TSPOrOpt implements the Or-opt local search heuristic for the Traveling Salesman Problem.
+
+Given a tour, Or-opt looks for a segment of up to 3 consecutive vertices that can be moved to a
+different position of the tour, possibly reversed, in a way that reduces the cost of the tour.
+The first improving move found is applied, and the search starts over, until no such move exists.
+
+The first vertex of the tour is never moved, so the resulting tour starts at the same vertex. If the tour
+does not visit every vertex of the graph exactly once, ErrInvTour is returned.
+
+Expectations:
+	- The graph is correctly built.
+	- The graph is undirected and complete.
+
+Complexity:
+	- Time:  O(V²) per improving move.
+	- Space: Θ(V²)
+*/
+func TSPOrOpt(g ds.Graph, t Tour) (Tour, float64, error) {
+	w, err := tspMatrix(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err = checkTour(len(w), t); err != nil {
+		return nil, 0, err
+	}
+
+	res := make(Tour, len(t))
+	copy(res, t)
+
+	for orOptPass(w, res) {
+	}
+
+	return res, tourCost(w, res), nil
+}
+
+/*
+TSPApprox builds a tour for the Traveling Salesman Problem using a construction algorithm
+(e.g.: TSPDoubleTree, TSPChristofides), and then improves it by applying each of the given
+local search heuristics (e.g.: TSP2Opt, TSPOrOpt), in order. The final tour is returned
+along with its cost.
+
+Local search heuristics never increase the cost of a tour, so any approximation
+guarantee given by the construction algorithm still holds for the final tour.
+*/
+func TSPApprox(g ds.Graph, build TSPAlgo, improve ...TSPImprover) (Tour, float64, error) {
+	tour, cost, err := build(g)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, imp := range improve {
+		tour, cost, err = imp(g, tour)
+
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return tour, cost, nil
+}
@@ -0,0 +1,210 @@
+package algo
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// tspEuclidean builds a complete undirected graph of random points on a plane, which is always metric.
+func tspEuclidean(r *rand.Rand, n int) *ds.G {
+	g := ds.NewGraph()
+
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+
+	for v := 0; v < n; v++ {
+		xs[v], ys[v] = r.Float64()*100, r.Float64()*100
+
+		g.AddVertex(ds.Text(strconv.Itoa(v)))
+	}
+
+	for v := 0; v < n; v++ {
+		for u := v + 1; u < n; u++ {
+			g.AddEdge(g.V[v].Item, g.V[u].Item, math.Hypot(xs[v]-xs[u], ys[v]-ys[u]))
+		}
+	}
+
+	return g
+}
+
+func TestTSPApprox(t *testing.T) {
+	cases := []struct {
+		desc    string
+		build   TSPAlgo
+		improve []TSPImprover
+		ratio   float64
+	}{
+		{
+			desc:  "double tree",
+			build: TSPDoubleTree,
+			ratio: 2,
+		},
+		{
+			desc:  "christofides",
+			build: TSPChristofides,
+			ratio: 1.5,
+		},
+		{
+			desc:    "double tree + 2-opt",
+			build:   TSPDoubleTree,
+			improve: []TSPImprover{TSP2Opt},
+			ratio:   2,
+		},
+		{
+			desc:    "christofides + or-opt",
+			build:   TSPChristofides,
+			improve: []TSPImprover{TSPOrOpt},
+			ratio:   1.5,
+		},
+		{
+			desc:    "christofides + 2-opt + or-opt",
+			build:   TSPChristofides,
+			improve: []TSPImprover{TSP2Opt, TSPOrOpt},
+			ratio:   1.5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+
+			for i := 0; i < 30; i++ {
+				g := tspEuclidean(r, 3+r.Intn(8))
+
+				_, best, err := TSPHeldKarp(g)
+
+				ut.Nil(t, err)
+
+				_, first, err := tc.build(g)
+
+				ut.Nil(t, err)
+
+				tour, cost, err := TSPApprox(g, tc.build, tc.improve...)
+
+				ut.Nil(t, err)
+				ut.Equal(t, 0, tour[0])
+				ut.True(t, math.Abs(cost-hamCheck(t, g, tour, true)) < 1e-9)
+				ut.True(t, cost <= first+1e-9)
+				ut.True(t, cost >= best-1e-9)
+				ut.True(t, cost <= tc.ratio*best+1e-9)
+			}
+		})
+	}
+}
+
+func TestTSPApprox_large(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	g := tspEuclidean(r, 60)
+
+	tour, cost, err := TSPApprox(g, TSPChristofides, TSP2Opt, TSPOrOpt)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, tour[0])
+	ut.True(t, math.Abs(cost-hamCheck(t, g, tour, true)) < 1e-9)
+
+	_, first, err := TSPChristofides(g)
+
+	ut.Nil(t, err)
+	ut.True(t, cost <= first+1e-9)
+}
+
+func TestTSPApprox_errors(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		err   error
+	}{
+		{
+			desc:  "directed",
+			input: ut.UDGSimple,
+			err:   ds.ErrDirected,
+		},
+		{
+			desc:  "incomplete",
+			input: ut.UUGSimple,
+			err:   ds.ErrIncomplete,
+		},
+		{
+			desc: "too small",
+			input: `
+			graph
+			a#b:1
+			b#a:1
+			`,
+			err: ds.ErrNoHamCycle,
+		},
+	}
+
+	algos := map[string]TSPAlgo{
+		"double tree":  TSPDoubleTree,
+		"christofides": TSPChristofides,
+	}
+
+	for _, tc := range cases {
+		for name, algo := range algos {
+			t.Run(tc.desc+" "+name, func(t *testing.T) {
+				g, _, err := ds.Parse(tc.input)
+
+				ut.Nil(t, err)
+
+				_, _, err = TSPApprox(g, algo)
+
+				ut.True(t, errors.Is(err, tc.err))
+			})
+		}
+	}
+}
+
+func TestTSPImprover_tour(t *testing.T) {
+	cases := []struct {
+		desc string
+		tour Tour
+	}{
+		{
+			desc: "nil",
+			tour: nil,
+		},
+		{
+			desc: "too short",
+			tour: Tour{0, 1, 2},
+		},
+		{
+			desc: "too long",
+			tour: Tour{0, 1, 2, 3, 4, 0},
+		},
+		{
+			desc: "repeated vertex",
+			tour: Tour{0, 1, 1, 3, 4},
+		},
+		{
+			desc: "unknown vertex",
+			tour: Tour{0, 1, 2, 3, 5},
+		},
+		{
+			desc: "negative vertex",
+			tour: Tour{0, 1, 2, 3, -1},
+		},
+	}
+
+	improvers := map[string]TSPImprover{
+		"2-opt":  TSP2Opt,
+		"or-opt": TSPOrOpt,
+	}
+
+	g := tspEuclidean(rand.New(rand.NewSource(42)), 5)
+
+	for _, tc := range cases {
+		for name, imp := range improvers {
+			t.Run(tc.desc+" "+name, func(t *testing.T) {
+				_, _, err := imp(g, tc.tour)
+				ut.True(t, errors.Is(err, ds.ErrInvTour))
+			})
+		}
+	}
+}
@@ -0,0 +1,211 @@
+package ds
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// writeField writes a length-prefixed field to a hash, so that sequences of fields are unambiguous.
+func writeField(h hash.Hash, s string) {
+	fmt.Fprintf(h, "%d:%s", len(s), s)
+}
+
+// wlNeighbors lists the colors of the vertices adjacent to a vertex, along with edge weights.
+func wlNeighbors(cs []string, es []GE, weights bool, end func(GE) int) []string {
+	res := make([]string, 0, len(es))
+
+	for _, e := range es {
+		if weights {
+			res = append(res, cs[end(e)]+"@"+strconv.FormatFloat(e.Wt, 'g', -1, 64))
+		} else {
+			res = append(res, cs[end(e)])
+		}
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+/*
+CanonicalHash calculates a fingerprint of a graph that does not depend on the order in which
+its vertices and edges were added, using the Weisfeiler-Lehman (WL) color refinement algorithm.
+
+Every vertex starts with the same color - or with the label of its Item, if labels is true - and
+then, on each iteration, the new color of a vertex is calculated by hashing its current color
+together with the sorted colors of its successors and predecessors - and the weights of the edges
+leading to them, if weights is true. The refinement stops once the number of distinct colors
+stops increasing, and the fingerprint is the hash of the sorted multiset of final colors.
+
+Isomorphic graphs always have the same fingerprint, so it can be safely used as a cache key,
+but the converse is not always true: WL cannot tell some non-isomorphic graphs apart (e.g.:
+regular graphs with the same degree and vertex count), so a cache hit needs to be confirmed
+by a comparison, like Equal, when false positives are not acceptable.
+
+Complexity:
+	- Time:  O(V (V + E) log V)
+	- Space: Θ(V + E)
+*/
+func CanonicalHash(g *G, labels bool, weights bool) string {
+	count := g.VertexCount()
+
+	preds := make([][]GE, count)
+
+	if g.Directed() {
+		for v := range g.V {
+			for _, e := range g.V[v].E {
+				preds[e.Dst] = append(preds[e.Dst], e)
+			}
+		}
+	}
+
+	cs := make([]string, count)
+
+	for v := range g.V {
+		if labels {
+			cs[v] = g.V[v].Label()
+		}
+	}
+
+	distinct := func(cs []string) int {
+		set := map[string]bool{}
+
+		for _, c := range cs {
+			set[c] = true
+		}
+
+		return len(set)
+	}
+
+	src := func(e GE) int { return e.Src }
+	dst := func(e GE) int { return e.Dst }
+
+	classes := distinct(cs)
+
+	for i := 0; i < count; i++ {
+		next := make([]string, count)
+
+		for v := range g.V {
+			h := sha256.New()
+
+			writeField(h, cs[v])
+
+			for _, c := range wlNeighbors(cs, g.V[v].E, weights, dst) {
+				writeField(h, c)
+			}
+
+			writeField(h, "|")
+
+			for _, c := range wlNeighbors(cs, preds[v], weights, src) {
+				writeField(h, c)
+			}
+
+			next[v] = hex.EncodeToString(h.Sum(nil))
+		}
+
+		cs = next
+
+		nextClasses := distinct(cs)
+
+		if nextClasses == classes {
+			break
+		}
+
+		classes = nextClasses
+	}
+
+	sort.Strings(cs)
+
+	h := sha256.New()
+
+	writeField(h, strconv.FormatBool(g.Directed()))
+	writeField(h, strconv.Itoa(g.VertexCount()))
+	writeField(h, strconv.Itoa(g.EdgeCount()))
+
+	for _, c := range cs {
+		writeField(h, c)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// edgeKey identifies an edge by the labels of its vertices and its weight (see wtKey).
+type edgeKey struct {
+	src string
+	dst string
+	wt  uint64
+}
+
+// wtKey maps a weight to a key that is equal for equal weights, with every NaN weight mapped to the same key.
+func wtKey(wt float64) uint64 {
+	switch {
+
+	case math.IsNaN(wt):
+		return math.Float64bits(math.NaN())
+
+	// 0 and -0 are equal weights
+	case wt == 0:
+		return 0
+	}
+
+	return math.Float64bits(wt)
+}
+
+/*
+Equal checks whether two graphs are the same, regardless of the order in which their vertices
+and edges were added: both graphs need to be either directed or undirected, have the same
+multiset of vertex labels, and the same multiset of edges, with each edge being identified
+by the labels of its vertices and by its weight. Weights are compared with ==, except for NaN
+weights, which are all equal to each other, so that Equal(g, g) always holds.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Equal(g1, g2 *G) bool {
+	if g1.Directed() != g2.Directed() {
+		return false
+	}
+
+	if g1.VertexCount() != g2.VertexCount() || g1.EdgeCount() != g2.EdgeCount() {
+		return false
+	}
+
+	vs := map[string]int{}
+	es := map[edgeKey]int{}
+
+	for v := range g1.V {
+		vs[g1.V[v].Label()]++
+
+		for _, e := range g1.V[v].E {
+			es[edgeKey{g1.V[e.Src].Label(), g1.V[e.Dst].Label(), wtKey(e.Wt)}]++
+		}
+	}
+
+	for v := range g2.V {
+		l := g2.V[v].Label()
+
+		if vs[l] == 0 {
+			return false
+		}
+
+		vs[l]--
+
+		for _, e := range g2.V[v].E {
+			k := edgeKey{g2.V[e.Src].Label(), g2.V[e.Dst].Label(), wtKey(e.Wt)}
+
+			if es[k] == 0 {
+				return false
+			}
+
+			es[k]--
+		}
+	}
+
+	return true
+}
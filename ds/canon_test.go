@@ -0,0 +1,148 @@
+package ds
+
+import (
+	"math"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// canonGraph builds a graph from a list of edges, adding vertices and edges in the given order.
+func canonGraph(t *testing.T, gType string, verts []Item, edges []edge) *G {
+	g := graphGen[gType]()
+
+	addVerts(t, g, verts...)
+	addEdges(t, g, edges...)
+
+	return g
+}
+
+func TestCanonicalHash(t *testing.T) {
+	for gType := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1 := canonGraph(t, gType,
+				[]Item{vA, vB, vC, vD},
+				[]edge{{vA, vB, 1}, {vB, vC, 2}, {vC, vD, 3}},
+			)
+
+			// same graph, different insertion order
+			g2 := canonGraph(t, gType,
+				[]Item{vD, vC, vB, vA},
+				[]edge{{vC, vD, 3}, {vB, vC, 2}, {vA, vB, 1}},
+			)
+
+			// same structure, different labels and weights
+			g3 := canonGraph(t, gType,
+				[]Item{vE, vB, vC, vD},
+				[]edge{{vE, vB, 1}, {vB, vC, 2}, {vC, vD, 4}},
+			)
+
+			// different structure
+			g4 := canonGraph(t, gType,
+				[]Item{vA, vB, vC, vD},
+				[]edge{{vA, vB, 1}, {vA, vC, 2}, {vA, vD, 3}},
+			)
+
+			for _, labels := range []bool{true, false} {
+				for _, weights := range []bool{true, false} {
+					ut.Equal(t, CanonicalHash(g1, labels, weights), CanonicalHash(g2, labels, weights))
+
+					ut.Equal(
+						t,
+						!labels && !weights,
+						CanonicalHash(g1, labels, weights) == CanonicalHash(g3, labels, weights),
+					)
+
+					ut.False(t, CanonicalHash(g1, labels, weights) == CanonicalHash(g4, labels, weights))
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalHash_direction(t *testing.T) {
+	g1 := canonGraph(t, undirectedGraphKey, []Item{vA, vB}, []edge{{vA, vB, 0}})
+	g2 := canonGraph(t, directedGraphKey, []Item{vA, vB}, []edge{{vA, vB, 0}, {vB, vA, 0}})
+
+	ut.False(t, CanonicalHash(g1, true, true) == CanonicalHash(g2, true, true))
+
+	// a -> b -> c, and a <- b -> c
+	g3 := canonGraph(t, directedGraphKey, []Item{vA, vB, vC}, []edge{{vA, vB, 0}, {vB, vC, 0}})
+	g4 := canonGraph(t, directedGraphKey, []Item{vA, vB, vC}, []edge{{vB, vA, 0}, {vB, vC, 0}})
+
+	ut.False(t, CanonicalHash(g3, false, false) == CanonicalHash(g4, false, false))
+}
+
+func TestEqual(t *testing.T) {
+	for gType := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1 := canonGraph(t, gType,
+				[]Item{vA, vB, vC},
+				[]edge{{vA, vB, 1}, {vB, vC, 2}},
+			)
+
+			g2 := canonGraph(t, gType,
+				[]Item{vC, vA, vB},
+				[]edge{{vB, vC, 2}, {vA, vB, 1}},
+			)
+
+			ut.True(t, Equal(g1, g2))
+			ut.True(t, Equal(g2, g1))
+
+			cases := []struct {
+				desc  string
+				verts []Item
+				edges []edge
+			}{
+				{
+					desc:  "different weight",
+					verts: []Item{vA, vB, vC},
+					edges: []edge{{vA, vB, 1}, {vB, vC, 3}},
+				},
+				{
+					desc:  "different edge",
+					verts: []Item{vA, vB, vC},
+					edges: []edge{{vA, vB, 1}, {vA, vC, 2}},
+				},
+				{
+					desc:  "different label",
+					verts: []Item{vA, vB, vD},
+					edges: []edge{{vA, vB, 1}, {vB, vD, 2}},
+				},
+				{
+					desc:  "extra vertex",
+					verts: []Item{vA, vB, vC, vD},
+					edges: []edge{{vA, vB, 1}, {vB, vC, 2}},
+				},
+			}
+
+			for _, tc := range cases {
+				t.Run(tc.desc, func(t *testing.T) {
+					ut.False(t, Equal(g1, canonGraph(t, gType, tc.verts, tc.edges)))
+				})
+			}
+		})
+	}
+
+	ut.False(t, Equal(NewGraph(), NewDigraph()))
+}
+
+func TestEqual_weights(t *testing.T) {
+	for gType := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := canonGraph(t, gType,
+				[]Item{vA, vB, vC},
+				[]edge{{vA, vB, math.NaN()}, {vB, vC, 0}},
+			)
+
+			ut.True(t, Equal(g, g))
+
+			g2 := canonGraph(t, gType,
+				[]Item{vA, vB, vC},
+				[]edge{{vA, vB, math.NaN()}, {vB, vC, math.Copysign(0, -1)}},
+			)
+
+			ut.True(t, Equal(g, g2))
+		})
+	}
+}
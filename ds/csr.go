@@ -0,0 +1,294 @@
+package ds
+
+/*
+CSR implements an immutable directed or undirected graph G = (V, E), using the Compressed
+Sparse Row format: the edges leaving every vertex are stored contiguously in a single
+array of destination vertices (and another one of weights), with an array of offsets
+marking where the edges of each vertex start. This results in a space complexity of
+Θ(V + E), like G, but with much smaller constant factors, since no per-vertex slices,
+edge structs or formatting attributes are kept around.
+
+A CSR graph cannot be modified after being built, which makes it a good fit for
+the analysis of large graphs: it can be built either from any other graph,
+using NewCSR, or from a stream of edges, using a CSRBuilder.
+
+The edges leaving a vertex are listed in the order in which they were added,
+and the Index of each edge is its position among the edges leaving the vertex.
+Like in G, every undirected edge is stored once for each of its vertices,
+but only counted once.
+*/
+type CSR struct {
+	items  []Item
+	sat    map[Item]int
+	dir    bool
+	eCount int
+
+	// offsets[v] is the position of the first edge leaving v, with offsets[|V|] being |E|.
+	offsets []int
+
+	// targets holds the destination vertex of every edge.
+	targets []int32
+
+	// weights holds the weight of every edge, or nil if every weight is 0.
+	weights []float64
+}
+
+// Directed checks whether or not the graph is directed.
+func (c *CSR) Directed() bool {
+	return c.dir
+}
+
+// Undirected checks whether or not the graph is undirected.
+func (c *CSR) Undirected() bool {
+	return !c.dir
+}
+
+// VertexCount calculates the size of the set of vertices, |V|, in O(1) time.
+func (c *CSR) VertexCount() int {
+	return len(c.items)
+}
+
+// EdgeCount calculates the size of the set of edges, |E|, in O(1) time.
+func (c *CSR) EdgeCount() int {
+	return c.eCount
+}
+
+// VertexIndex retrieves the index of the vertex associated with the given Item.
+func (c *CSR) VertexIndex(i Item) (int, bool) {
+	idx, ok := c.sat[i]
+	return idx, ok
+}
+
+// Item retrieves the Item associated with the vertex at the given index.
+func (c *CSR) Item(v int) Item {
+	return c.items[v]
+}
+
+// OutDegree calculates the number of edges leaving the vertex at the given index, in O(1) time.
+func (c *CSR) OutDegree(v int) int {
+	return c.offsets[v+1] - c.offsets[v]
+}
+
+// ForEachEdge calls fn for every edge leaving the vertex at the given index, in insertion order.
+func (c *CSR) ForEachEdge(v int, fn func(GE)) {
+	start, end := c.offsets[v], c.offsets[v+1]
+
+	for i := start; i < end; i++ {
+		e := GE{
+			Src:   v,
+			Dst:   int(c.targets[i]),
+			Index: i - start,
+		}
+
+		if c.weights != nil {
+			e.Wt = c.weights[i]
+		}
+
+		fn(e)
+	}
+}
+
+// Walk guides the execution of a Visitor over every vertex and edge of the graph, in order.
+func (c *CSR) Walk(vis Visitor) {
+	walk(c, vis, func(v int) GV {
+		return GV{Item: c.items[v], Index: v}
+	})
+}
+
+/*
+A CSRBuilder builds a CSR graph from a stream of vertices and edges. Edges are buffered
+in compact arrays until Build is called, when they are grouped by their source vertex
+in Θ(V + E) time, using a counting sort.
+
+For speed, the builder does not check whether an edge has already been added, so adding
+the same edge twice results in a graph with two edges between the same vertices.
+*/
+type CSRBuilder struct {
+	items  []Item
+	sat    map[Item]int
+	dir    bool
+	eCount int
+
+	src []int32
+	dst []int32
+	wt  []float64
+
+	weighted bool
+}
+
+// NewCSRBuilder creates a new CSRBuilder, for either a directed or an undirected graph.
+func NewCSRBuilder(dir bool) *CSRBuilder {
+	b := &CSRBuilder{}
+
+	b.sat = map[Item]int{}
+	b.dir = dir
+
+	return b
+}
+
+// AddVertex adds a new vertex to the graph being built, associated with the given Item.
+func (b *CSRBuilder) AddVertex(i Item) (int, error) {
+	if _, ok := b.sat[i]; ok {
+		return 0, ErrExists
+	}
+
+	b.items = append(b.items, i)
+	b.sat[i] = len(b.items) - 1
+
+	return len(b.items) - 1, nil
+}
+
+/*
+AddEdge adds a new weighted edge between the given Items, but only if their vertices have already been added.
+In an undirected graph, the reverse copy of the edge is added as well.
+*/
+func (b *CSRBuilder) AddEdge(src Item, dst Item, wt float64) error {
+	if !b.dir && src == dst {
+		return ErrInvLoop
+	}
+
+	iSrc, ok := b.sat[src]
+
+	if !ok {
+		return ErrNoVtx
+	}
+
+	iDst, ok := b.sat[dst]
+
+	if !ok {
+		return ErrNoVtx
+	}
+
+	b.addEdge(iSrc, iDst, wt)
+
+	if !b.dir {
+		b.addEdge(iDst, iSrc, wt)
+	}
+
+	b.eCount++
+
+	return nil
+}
+
+func (b *CSRBuilder) addEdge(src, dst int, wt float64) {
+	b.src = append(b.src, int32(src))
+	b.dst = append(b.dst, int32(dst))
+	b.wt = append(b.wt, wt)
+
+	if wt != 0 {
+		b.weighted = true
+	}
+}
+
+/*
+Build builds the CSR graph from every vertex and edge added so far.
+The builder can still be used afterwards, without affecting the graph.
+*/
+func (b *CSRBuilder) Build() *CSR {
+	n := len(b.items)
+
+	c := &CSR{
+		items:   make([]Item, n),
+		sat:     make(map[Item]int, n),
+		dir:     b.dir,
+		eCount:  b.eCount,
+		offsets: make([]int, n+1),
+		targets: make([]int32, len(b.dst)),
+	}
+
+	copy(c.items, b.items)
+
+	for i, v := range c.items {
+		c.sat[v] = i
+	}
+
+	if b.weighted {
+		c.weights = make([]float64, len(b.wt))
+	}
+
+	for _, src := range b.src {
+		c.offsets[src+1]++
+	}
+
+	for v := 0; v < n; v++ {
+		c.offsets[v+1] += c.offsets[v]
+	}
+
+	// next[v] is where the next edge leaving v goes, and
+	// since edges are placed in the order they were
+	// added, the sorting is stable
+	next := make([]int, n)
+	copy(next, c.offsets)
+
+	for i, src := range b.src {
+		pos := next[src]
+		next[src]++
+
+		c.targets[pos] = b.dst[i]
+
+		if c.weights != nil {
+			c.weights[pos] = b.wt[i]
+		}
+	}
+
+	return c
+}
+
+/*
+NewCSR builds a CSR graph that holds the same vertices and edges as the given graph,
+with vertices keeping their indexes, and edges leaving each vertex keeping their order.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func NewCSR(g Graph) *CSR {
+	b := NewCSRBuilder(g.Directed())
+
+	for v := 0; v < g.VertexCount(); v++ {
+		b.AddVertex(g.Item(v))
+	}
+
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e GE) {
+			b.addEdge(e.Src, e.Dst, e.Wt)
+		})
+	}
+
+	// the copies of every undirected edge
+	// have already been listed by g
+	b.eCount = g.EdgeCount()
+
+	return b.Build()
+}
+
+/*
+TransposeCSR builds a CSR transpose of a directed graph, where all original edges are reversed.
+Being immutable and compact, a CSR transpose is a better fit than Transpose for algorithms that
+only need to read the transpose of a graph.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func TransposeCSR(g Graph) (*CSR, error) {
+	if g.Undirected() {
+		return nil, ErrUndirected
+	}
+
+	b := NewCSRBuilder(true)
+
+	for v := 0; v < g.VertexCount(); v++ {
+		b.AddVertex(g.Item(v))
+	}
+
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e GE) {
+			b.addEdge(e.Dst, e.Src, e.Wt)
+		})
+	}
+
+	b.eCount = g.EdgeCount()
+
+	return b.Build(), nil
+}
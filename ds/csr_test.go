@@ -0,0 +1,173 @@
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// sameCSR checks that a CSR graph holds the same vertices and edges as an adjacency list, in the same order.
+func sameCSR(t *testing.T, g *G, c *CSR) {
+	ut.Equal(t, g.Directed(), c.Directed())
+	ut.Equal(t, g.VertexCount(), c.VertexCount())
+	ut.Equal(t, g.EdgeCount(), c.EdgeCount())
+
+	for v := range g.V {
+		ut.True(t, g.V[v].Item == c.Item(v))
+
+		idx, ok := c.VertexIndex(g.V[v].Item)
+
+		ut.True(t, ok)
+		ut.Equal(t, v, idx)
+		ut.Equal(t, len(g.V[v].E), c.OutDegree(v))
+
+		i := 0
+
+		c.ForEachEdge(v, func(e GE) {
+			ut.Equal(t, g.V[v].E[i].Src, e.Src)
+			ut.Equal(t, g.V[v].E[i].Dst, e.Dst)
+			ut.Equal(t, g.V[v].E[i].Wt, e.Wt)
+			ut.Equal(t, i, e.Index)
+
+			i++
+		})
+	}
+}
+
+func TestNewCSR(t *testing.T) {
+	for name, gen := range graphGen {
+		t.Run(name, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD, vE)
+
+			addEdges(t, g,
+				edge{vA, vB, 1},
+				edge{vA, vC, 0},
+				edge{vD, vA, 2.5},
+				edge{vC, vB, 3},
+				edge{vC, vD, 0},
+			)
+
+			sameCSR(t, g, NewCSR(g))
+		})
+	}
+}
+
+func TestNewCSR_unweighted(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, edge{vA, vB, 0}, edge{vB, vC, 0})
+
+	c := NewCSR(g)
+
+	ut.Nil(t, c.weights)
+
+	sameCSR(t, g, c)
+}
+
+func TestCSRBuilder(t *testing.T) {
+	b := NewCSRBuilder(true)
+
+	_, err := b.AddVertex(vA)
+	ut.Nil(t, err)
+
+	_, err = b.AddVertex(vB)
+	ut.Nil(t, err)
+
+	_, err = b.AddVertex(vA)
+	ut.True(t, errors.Is(err, ErrExists))
+
+	ut.Nil(t, b.AddEdge(vB, vA, 1))
+	ut.Nil(t, b.AddEdge(vA, vA, 2))
+	ut.Nil(t, b.AddEdge(vA, vB, 3))
+
+	ut.True(t, errors.Is(b.AddEdge(vA, vC, 1), ErrNoVtx))
+	ut.True(t, errors.Is(b.AddEdge(vC, vA, 1), ErrNoVtx))
+
+	c := b.Build()
+
+	ut.Equal(t, 2, c.VertexCount())
+	ut.Equal(t, 3, c.EdgeCount())
+	ut.Equal(t, 2, c.OutDegree(0))
+	ut.Equal(t, 1, c.OutDegree(1))
+
+	es := []GE{}
+
+	c.ForEachEdge(0, func(e GE) {
+		es = append(es, e)
+	})
+
+	ut.Equal(t, 0, es[0].Dst)
+	ut.Equal(t, 2.0, es[0].Wt)
+	ut.Equal(t, 1, es[1].Dst)
+	ut.Equal(t, 3.0, es[1].Wt)
+
+	// the graph that was built is not affected by the builder
+	b.AddVertex(vC)
+	b.AddEdge(vB, vC, 1)
+
+	ut.Equal(t, 2, c.VertexCount())
+	ut.Equal(t, 3, c.EdgeCount())
+}
+
+func TestCSRBuilder_undirected(t *testing.T) {
+	b := NewCSRBuilder(false)
+
+	b.AddVertex(vA)
+	b.AddVertex(vB)
+
+	ut.Nil(t, b.AddEdge(vA, vB, 2))
+
+	c := b.Build()
+
+	ut.Equal(t, 1, c.EdgeCount())
+	ut.Equal(t, 1, c.OutDegree(0))
+	ut.Equal(t, 1, c.OutDegree(1))
+}
+
+func TestCSRBuilder_undirected_loop(t *testing.T) {
+	b := NewCSRBuilder(false)
+
+	b.AddVertex(vA)
+
+	ut.True(t, errors.Is(b.AddEdge(vA, vA, 1), ErrInvLoop))
+}
+
+func TestTransposeCSR(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, edge{vA, vB, 1}, edge{vA, vC, 2}, edge{vC, vB, 3})
+
+	tg, err := Transpose(g)
+
+	ut.Nil(t, err)
+
+	c, err := TransposeCSR(g)
+
+	ut.Nil(t, err)
+
+	sameCSR(t, tg, c)
+
+	_, err = TransposeCSR(NewGraph())
+
+	ut.True(t, errors.Is(err, ErrUndirected))
+}
+
+func TestCSRWalk(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, edge{vA, vB, 1}, edge{vA, vC, 2}, edge{vC, vB, 3})
+
+	vis := &counterVisitor{}
+
+	NewCSR(g).Walk(vis)
+
+	ut.Equal(t, 2, vis.gCalls)
+	ut.Equal(t, 3, vis.vCalls)
+	ut.Equal(t, 3, vis.eCalls)
+}
@@ -0,0 +1,119 @@
+package ds
+
+/*
+An EdgeChange describes an edge that differs between two versions of a graph,
+identified by the labels of its vertices.
+*/
+type EdgeChange struct {
+	Src string
+	Dst string
+
+	// OldWt is the weight of the edge in the old graph, 0 if the edge was added.
+	OldWt float64
+
+	// NewWt is the weight of the edge in the new graph, 0 if the edge was removed.
+	NewWt float64
+
+	// K is the position of the edge among the edges between the same vertices (in either direction, if
+	// undirected), in the graph where it exists, telling apart parallel edges in multigraphs.
+	K int
+}
+
+// A GraphDiff lists the changes needed to turn an old version of a graph into a new one, keyed by vertex labels.
+type GraphDiff struct {
+	AddedVertices   []string
+	RemovedVertices []string
+
+	AddedEdges      []EdgeChange
+	RemovedEdges    []EdgeChange
+	ReweightedEdges []EdgeChange
+}
+
+// Empty checks whether or not the diff has no changes at all.
+func (d *GraphDiff) Empty() bool {
+	return len(d.AddedVertices) == 0 &&
+		len(d.RemovedVertices) == 0 &&
+		len(d.AddedEdges) == 0 &&
+		len(d.RemovedEdges) == 0 &&
+		len(d.ReweightedEdges) == 0
+}
+
+/*
+Diff calculates the changes that turn an old version of a graph (from) into a new one (to), which must
+be either both directed or both undirected. Vertices are matched by their labels, which makes it possible
+to compare graphs that were built separately (e.g. parsed from different files), so labels must be unique
+in each graph, or else ErrDupKey is returned. Edges are matched like in Union, with matched edges of
+different weights being reported as reweighted. Changes are listed in the order they appear in the old
+graph, and then in the order they appear in the new graph.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Diff(from, to Graph) (*GraphDiff, error) {
+	op, err := newSetOp(from, to, []SetOpt{MatchBy(func(l string) string { return l })})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GraphDiff{}
+
+	for _, v := range op.vs {
+		switch {
+
+		case v.v1 == -1:
+			res.AddedVertices = append(res.AddedVertices, v.item.Label())
+
+		case v.v2 == -1:
+			res.RemovedVertices = append(res.RemovedVertices, v.item.Label())
+		}
+	}
+
+	// edges are matched by their positions between the same vertices,
+	// so a matched pair of edges is at the same position in both graphs
+	seen1 := map[[2]int]int{}
+	seen2 := map[[2]int]int{}
+
+	change := func(e setEdge, seen map[[2]int]int) EdgeChange {
+		k := op.pair(e)
+		seen[k]++
+
+		return EdgeChange{
+			Src: op.vs[e.src].item.Label(),
+			Dst: op.vs[e.dst].item.Label(),
+			K:   seen[k] - 1,
+		}
+	}
+
+	for i, e := range op.es1 {
+		j := op.match1[i]
+
+		c := change(e, seen1)
+		c.OldWt = e.wt
+
+		if j == -1 {
+			res.RemovedEdges = append(res.RemovedEdges, c)
+			continue
+		}
+
+		if e.wt != op.es2[j].wt {
+			c.NewWt = op.es2[j].wt
+			res.ReweightedEdges = append(res.ReweightedEdges, c)
+		}
+	}
+
+	for j, e := range op.es2 {
+		c := change(e, seen2)
+
+		if op.match2[j] {
+			continue
+		}
+
+		c.NewWt = e.wt
+
+		res.AddedEdges = append(res.AddedEdges, c)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestDiff(t *testing.T) {
+	for gType := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			from, _, err := Parse(gType + `
+			a#b:1,c:2
+			b#a:1
+			c#a:2
+			`)
+
+			ut.Nil(t, err)
+
+			to, _, err := Parse(gType + `
+			a#b:5,d:3
+			b#a:5
+			d#a:3
+			`)
+
+			ut.Nil(t, err)
+
+			d, err := Diff(from, to)
+
+			ut.Nil(t, err)
+			ut.False(t, d.Empty())
+
+			ut.Equal(t, 1, len(d.AddedVertices))
+			ut.Equal(t, "d", d.AddedVertices[0])
+
+			ut.Equal(t, 1, len(d.RemovedVertices))
+			ut.Equal(t, "c", d.RemovedVertices[0])
+
+			// directed edges are listed in both directions
+			count := 2
+
+			if gType == undirectedGraphKey {
+				count = 1
+			}
+
+			ut.Equal(t, count, len(d.ReweightedEdges))
+			ut.Equal(t, count, len(d.AddedEdges))
+			ut.Equal(t, count, len(d.RemovedEdges))
+
+			ut.Equal(t, "a", d.ReweightedEdges[0].Src)
+			ut.Equal(t, "b", d.ReweightedEdges[0].Dst)
+			ut.Equal(t, 1.0, d.ReweightedEdges[0].OldWt)
+			ut.Equal(t, 5.0, d.ReweightedEdges[0].NewWt)
+
+			ut.Equal(t, "a", d.AddedEdges[0].Src)
+			ut.Equal(t, "d", d.AddedEdges[0].Dst)
+			ut.Equal(t, 3.0, d.AddedEdges[0].NewWt)
+
+			ut.Equal(t, "a", d.RemovedEdges[0].Src)
+			ut.Equal(t, "c", d.RemovedEdges[0].Dst)
+			ut.Equal(t, 2.0, d.RemovedEdges[0].OldWt)
+		})
+	}
+}
+
+func TestDiff_same(t *testing.T) {
+	from, _, err := Parse(ut.UDGDeps)
+	ut.Nil(t, err)
+
+	to, _, err := Parse(ut.UDGDeps)
+	ut.Nil(t, err)
+
+	d, err := Diff(from, to)
+
+	ut.Nil(t, err)
+	ut.True(t, d.Empty())
+}
+
+func TestDiff_multigraph(t *testing.T) {
+	a, b := Text("a"), Text("b")
+
+	from := NewDigraphWith(Multigraph())
+	to := NewDigraphWith(Multigraph())
+
+	for _, g := range []*G{from, to} {
+		_, err := g.AddVertex(a)
+		ut.Nil(t, err)
+
+		_, err = g.AddVertex(b)
+		ut.Nil(t, err)
+	}
+
+	for _, wt := range []float64{1, 3, 1} {
+		_, _, err := from.AddEdge(a, b, wt)
+		ut.Nil(t, err)
+	}
+
+	for _, wt := range []float64{1, 4} {
+		_, _, err := to.AddEdge(a, b, wt)
+		ut.Nil(t, err)
+	}
+
+	d, err := Diff(from, to)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 1, len(d.ReweightedEdges))
+	ut.Equal(t, 1, d.ReweightedEdges[0].K)
+
+	ut.Equal(t, 1, len(d.RemovedEdges))
+	ut.Equal(t, 2, d.RemovedEdges[0].K)
+}
+
+func TestDiff_mixed(t *testing.T) {
+	_, err := Diff(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+}
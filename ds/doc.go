@@ -1,2 +1,15 @@
-// Package ds provides implementations for data structures, like graphs. Any assertions regarding asymptotic behavior assume the worst-case scenario.
+/*
+Package ds provides implementations for data structures, like graphs. Any assertions regarding asymptotic behavior assume the worst-case scenario.
+
+Undirected graphs keep linked copies of their edges (see GE.Rev), so AddEdge adds both copies of an edge at once,
+and both copies always have the same weight. This changed how text is parsed as well: Parse now links the copies of
+every undirected edge listed in the input, and rejects inputs in which they have different weights, which used to be
+accepted, reporting ErrWtConflict. Inputs like these can still be parsed, with every copy kept as listed, by setting
+TextParser.LegacyUndirected.
+
+Graphs can be visited in two ways. A GraphVisitor keeps its original signatures, receiving a G, and can only visit a G,
+through G.Accept. A Visitor receives a Graph instead, and can visit every Visitable graph (G, M and CSR), through Walk.
+Visitors that used the short-lived Graph signatures of GraphVisitor, or called Accept on M or CSR, need to be moved to
+Visitor and Walk.
+*/
 package ds
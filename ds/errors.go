@@ -13,6 +13,10 @@ var ErrUndirected = WrapErr(ErrUndefOp, "undirected graph")
 
 var ErrDisconnected = WrapErr(ErrUndefOp, "disconnected graph")
 
+var ErrIncomplete = WrapErr(ErrUndefOp, "incomplete graph")
+
+var ErrMixedGraphs = WrapErr(ErrUndefOp, "directed and undirected graphs")
+
 var ErrDoesNotExist = errors.New("does not exist")
 
 var ErrNoVtx = WrapErr(ErrDoesNotExist, "vertex")
@@ -21,14 +25,32 @@ var ErrNoEdge = WrapErr(ErrDoesNotExist, "edge")
 
 var ErrNoRevEdge = WrapErr(ErrDoesNotExist, "reverse edge")
 
+var ErrNoHamPath = WrapErr(ErrDoesNotExist, "hamiltonian path")
+
+var ErrNoHamCycle = WrapErr(ErrDoesNotExist, "hamiltonian cycle")
+
 var ErrExists = errors.New("already exists")
 
+var ErrDupKey = WrapErr(ErrExists, "vertex key")
+
+var ErrDupLabel = WrapErr(ErrExists, "vertex label")
+
+var ErrDupEdge = WrapErr(ErrExists, "parallel edge")
+
 var ErrNilArg = errors.New("nil argument")
 
 var ErrInvLoop = errors.New("invalid loop")
 
 var ErrInvType = errors.New("invalid type")
 
+var ErrInvPartition = errors.New("invalid partition")
+
+var ErrInvTour = errors.New("invalid tour")
+
+var ErrInvLabel = errors.New("invalid label")
+
+var ErrWtConflict = errors.New("conflicting weights")
+
 // WrapErr wraps an error using the fmt.Errorf function.
 func WrapErr(err error, msg string) error {
 	return fmt.Errorf("%s: %w", msg, err)
@@ -39,3 +39,18 @@ func (f *Formattable) ResetFmt() {
 
 	f.F = make(FAttrs)
 }
+
+// cloneFmt creates a copy of the current formatting attributes, if any.
+func (f *Formattable) cloneFmt() Formattable {
+	if f.F == nil {
+		return Formattable{}
+	}
+
+	res := Formattable{F: make(FAttrs, len(f.F))}
+
+	for k, v := range f.F {
+		res.F[k] = v
+	}
+
+	return res
+}
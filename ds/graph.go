@@ -33,6 +33,20 @@ type GE struct {
 		This information is useful when passing around copies of the edge.
 	*/
 	Index int
+
+	/*
+		Rev is the position of the reverse copy of this edge in Dst's adjacency list.
+		An undirected edge is stored in the adjacency lists of both of its vertices,
+		and Rev links each copy to the other one. This information is only kept
+		by undirected graphs, unless they were created using LegacyUndirected.
+
+		In a directed graph created using InEdgeIndex, Rev is instead
+		the position of this edge among the edges returned by InEdges(Dst).
+	*/
+	Rev int
+
+	// ID is the stable handle of the edge, kept by G (see EdgeID).
+	ID EdgeID
 }
 
 func (e *GE) String() string {
@@ -55,8 +69,14 @@ type GV struct {
 	// Index is the index of the vertex in the list of vertices of the graph.
 	Index int
 
+	// ID is the stable handle of the vertex, kept by G (see VertexID).
+	ID VertexID
+
 	// E is the adjacency list for the vertex, listing all edges that the vertex as their source.
 	E []GE
+
+	// dead marks the slot of a vertex that was removed from a graph created using Tombstones.
+	dead bool
 }
 
 func (v *GV) Label() string {
@@ -79,14 +99,61 @@ func (v *GV) String() string {
 	return b.String()
 }
 
+/*
+Graph is a read-only view of a directed or undirected graph, implemented by every graph
+representation in this package, where vertices are identified by their index, starting
+at 0, and associated with an Item. Algorithms that only need to read a graph accept
+this interface, so any storage can be used, as long as it can be viewed this way.
+*/
+type Graph interface {
+	// Directed checks whether or not the graph is directed.
+	Directed() bool
+
+	// Undirected checks whether or not the graph is undirected.
+	Undirected() bool
+
+	// VertexCount calculates the size of the set of vertices, |V|.
+	VertexCount() int
+
+	// EdgeCount calculates the size of the set of edges, |E|.
+	EdgeCount() int
+
+	// VertexIndex retrieves the index of the vertex associated with the given Item.
+	VertexIndex(i Item) (int, bool)
+
+	// Item retrieves the Item associated with the vertex at the given index.
+	Item(v int) Item
+
+	/*
+		ForEachEdge calls fn for every edge leaving the vertex at the given index,
+		always in the same order, as long as the graph is not modified. The Index
+		of each edge needs to identify it among the edges leaving the vertex.
+	*/
+	ForEachEdge(v int, fn func(GE))
+}
+
 /*
 G implements a directed or undirected graph G = (V, E), using adjacency lists
 to achieve linear space complexity: Θ(V + E).
 
 Due to the nature of adjacency lists, checking whether an edge (u, v) exists
 has O(E) time complexity. For applications that make heavy use of this operation,
-an adjacency matrix would be a better fit (O(1) time complexity), with the
+an adjacency matrix (M) is a better fit (O(1) time complexity), with the
 trade-off being a worse space complexity of Θ(V²).
+
+In an undirected graph, adding the edge (u, v) also adds its reverse copy (v, u)
+to the adjacency list of v, with both copies linked to each other (see GE.Rev),
+and removing either copy removes both of them. The edge is only counted once.
+The LegacyUndirected option restores the original behavior, where callers
+are responsible for adding and removing both copies of every edge.
+
+By default, at most one edge (u, v) can exist, but a multigraph can be created
+by using the Multigraph option (see NewGraphWith), allowing parallel edges:
+multiple edges (u, v), each one identified by its index in the adjacency list of u.
+
+Removing a vertex shifts the index of every later vertex, unless the graph was created
+using the Tombstones option, where the vertex leaves a dead slot behind instead, so that
+indexes held elsewhere stay valid until the dead slots are reclaimed by Compact.
 */
 type G struct {
 	/*
@@ -97,11 +164,84 @@ type G struct {
 
 	sat    map[Item]int
 	dir    bool
+	multi  bool
+	legacy bool
+	inIdx  bool
+	tomb   bool
 	eCount int
 	vCount int
+
+	// holes is the number of dead slots in V, left by removed vertices (see Tombstones).
+	holes int
+
+	// vIDs and eIDs track the current location of every vertex and edge handle.
+	vIDs idTable
+	eIDs idTable
+
+	// in holds, for every vertex, the location of the edges that arrive at it (see InEdgeIndex).
+	in [][]inRef
+}
+
+// inRef locates an edge by the index of its source vertex, and its index in the adjacency list of that vertex.
+type inRef struct {
+	src int
+	idx int
+}
+
+// A GraphOpt configures a graph during its creation.
+type GraphOpt func(*G)
+
+// Multigraph configures a graph to allow parallel edges between the same pair of vertices.
+func Multigraph() GraphOpt {
+	return func(g *G) {
+		g.multi = true
+	}
+}
+
+/*
+LegacyUndirected configures an undirected graph to keep the original behavior
+of AddEdge, RemoveEdge and EdgeCount: adding or removing an edge only affects
+the adjacency list of its source, and each copy of an edge is counted.
+It is meant to help migrating code that still adds both copies of every edge.
+*/
+func LegacyUndirected() GraphOpt {
+	return func(g *G) {
+		g.legacy = true
+	}
+}
+
+/*
+InEdgeIndex configures a directed graph to keep an index of the edges arriving at every vertex,
+which is maintained by AddEdge, RemoveEdge and RemoveVertex, making InEdges and InDegree run in
+time proportional to the number of incoming edges, instead of having to check every edge.
+Undirected graphs do not need the index, since every edge arrives at the vertices it leaves.
+*/
+func InEdgeIndex() GraphOpt {
+	return func(g *G) {
+		g.inIdx = true
+	}
+}
+
+/*
+Tombstones configures a graph to remove vertices without shifting the index of any other vertex:
+RemoveVertex only removes the edges incident on the vertex, leaving a dead slot in V, which
+keeps every index held elsewhere valid, and removes the vertex in time proportional to the
+degrees of the vertex and of its neighbors, instead of Θ(V + E). Directed graphs also keep
+the index of incoming edges (see InEdgeIndex), which is how the removal finds them.
+
+Dead slots stay in V, with no edges, until Compact is called, shifting vertices into them.
+Until then, the graph can be changed, and its vertices and edges can be accessed through their
+indexes and handles, but reading it as a whole (e.g.: through the Graph interface, by an algorithm,
+or by a visitor) needs the vertices to be contiguous, and the graph to be compacted first.
+*/
+func Tombstones() GraphOpt {
+	return func(g *G) {
+		g.tomb = true
+		g.inIdx = true
+	}
 }
 
-func newG(dir bool) *G {
+func newG(dir bool, opts []GraphOpt) *G {
 	g := &G{}
 
 	g.V = make([]GV, 0)
@@ -109,17 +249,31 @@ func newG(dir bool) *G {
 	g.sat = map[Item]int{}
 	g.dir = dir
 
+	for _, opt := range opts {
+		opt(g)
+	}
+
 	return g
 }
 
 // NewGraph creates a new undirected graph.
 func NewGraph() *G {
-	return newG(false)
+	return newG(false, nil)
 }
 
 // NewDigraph creates a new directed graph.
 func NewDigraph() *G {
-	return newG(true)
+	return newG(true, nil)
+}
+
+// NewGraphWith creates a new undirected graph, configured by the given options.
+func NewGraphWith(opts ...GraphOpt) *G {
+	return newG(false, opts)
+}
+
+// NewDigraphWith creates a new directed graph, configured by the given options.
+func NewDigraphWith(opts ...GraphOpt) *G {
+	return newG(true, opts)
 }
 
 func (g *G) String() string {
@@ -151,12 +305,38 @@ func (g *G) Undirected() bool {
 	return !g.dir
 }
 
+/*
+IsRemoved checks whether or not the slot at the given index of V was left by a removed vertex,
+which can only happen in a graph created using Tombstones, until Compact is called.
+*/
+func (g *G) IsRemoved(v int) bool {
+	return g.V[v].dead
+}
+
+// IsMultigraph checks whether or not the graph allows parallel edges.
+func (g *G) IsMultigraph() bool {
+	return g.multi
+}
+
+// indexed checks whether or not the graph keeps an index of incoming edges.
+func (g *G) indexed() bool {
+	return g.dir && g.inIdx
+}
+
+// linked checks whether or not the graph keeps linked copies of its edges.
+func (g *G) linked() bool {
+	return !g.dir && !g.legacy
+}
+
 // VertexCount calculates the size of the set of vertices, |V|, in O(1) time.
 func (g *G) VertexCount() int {
 	return g.vCount
 }
 
-// EdgeCount calculates the size of the set of edges, |E|, in O(1) time.
+/*
+EdgeCount calculates the size of the set of edges, |E|, in O(1) time.
+Both copies of an undirected edge count as a single edge.
+*/
 func (g *G) EdgeCount() int {
 	return g.eCount
 }
@@ -167,7 +347,22 @@ func (g *G) VertexIndex(i Item) (int, bool) {
 	return idx, ok
 }
 
-// EdgeIndex retrieves the index(es) of the edge associated with the given Items.
+// Item retrieves the Item associated with the vertex at the given index.
+func (g *G) Item(v int) Item {
+	return g.V[v].Item
+}
+
+// ForEachEdge calls fn for every edge leaving the vertex at the given index, in insertion order.
+func (g *G) ForEachEdge(v int, fn func(GE)) {
+	for _, e := range g.V[v].E {
+		fn(e)
+	}
+}
+
+/*
+EdgeIndex retrieves the index(es) of the edge associated with the given Items.
+In a multigraph, the first of any parallel edges is the one retrieved.
+*/
 func (g *G) EdgeIndex(src Item, dst Item) (int, int, bool) {
 	iSrc, ok := g.VertexIndex(src)
 
@@ -203,12 +398,23 @@ func (g *G) AddVertex(i Item) (int, error) {
 	g.V[idx].Index = idx
 	g.sat[i] = idx
 
+	slot, gen := g.vIDs.alloc(idx, 0)
+	g.V[idx].ID = VertexID{slot, gen}
+
+	if g.indexed() {
+		g.in = append(g.in, nil)
+	}
+
 	g.vCount++
 
 	return idx, nil
 }
 
-// AddEdge adds a new weighted edge between the given Items, but only if their vertices have already been added.
+/*
+AddEdge adds a new weighted edge between the given Items, but only if their vertices have already been added.
+Unless the graph is a multigraph, an edge between the same Items cannot be added more than once.
+In an undirected graph, the reverse copy of the edge is added as well.
+*/
 func (g *G) AddEdge(src Item, dst Item, wt float64) (int, int, error) {
 	if g.Undirected() && src == dst {
 		return 0, 0, ErrInvLoop
@@ -226,9 +432,11 @@ func (g *G) AddEdge(src Item, dst Item, wt float64) (int, int, error) {
 		return 0, 0, ErrNoVtx
 	}
 
-	for e := range g.V[iSrc].E {
-		if g.V[iSrc].E[e].Dst == iDst {
-			return 0, 0, ErrExists
+	if !g.multi {
+		for e := range g.V[iSrc].E {
+			if g.V[iSrc].E[e].Dst == iDst {
+				return 0, 0, ErrExists
+			}
 		}
 	}
 
@@ -244,10 +452,55 @@ func (g *G) AddEdge(src Item, dst Item, wt float64) (int, int, error) {
 
 	g.eCount++
 
-	return iSrc, len(g.V[iSrc].E) - 1, nil
+	idx := len(g.V[iSrc].E) - 1
+
+	slot, gen := g.eIDs.alloc(g.V[iSrc].ID.slot, idx)
+	g.V[iSrc].E[idx].ID = EdgeID{slot, gen}
+
+	if g.indexed() {
+		g.in[iDst] = append(g.in[iDst], inRef{iSrc, idx})
+		g.V[iSrc].E[idx].Rev = len(g.in[iDst]) - 1
+	}
+
+	if g.linked() {
+		g.V[iDst].E = append(
+			g.V[iDst].E,
+			GE{
+				Index: len(g.V[iDst].E),
+				Src:   iDst,
+				Dst:   iSrc,
+				Wt:    wt,
+				Rev:   idx,
+				ID:    g.V[iSrc].E[idx].ID,
+			},
+		)
+
+		g.V[iSrc].E[idx].Rev = len(g.V[iDst].E) - 1
+	}
+
+	return iSrc, idx, nil
 }
 
-// RemoveVertex removes the vertex associated with the given Item, along with any edges incident on it.
+/*
+RemoveVertex removes the vertex associated with the given Item, along with any edges incident on it.
+Every later vertex has its index shifted, as do the edges that lead to it, so indexes held elsewhere
+become outdated, while the handles of every remaining vertex and edge stay valid (see VertexID).
+
+Shifting indexes means visiting every edge of the graph, so removing a vertex takes Θ(V + E) time,
+regardless of its degree. Callers that remove many vertices should use RemoveVertices, and callers
+that do not need the order of the vertices, SwapRemoveVertex.
+
+Graphs created using Tombstones shift nothing: the vertex leaves a dead slot behind (see IsRemoved),
+and only the edges incident on it are removed, found through their reverse copies (see GE.Rev)
+or through the index of incoming edges, so every index held elsewhere stays valid until Compact is called.
+Undirected graphs created using LegacyUndirected do not link their copies, and still look for the edges
+arriving at the vertex in every adjacency list.
+
+Complexity:
+	- Time:  O(Σ deg(u)), for every u that is the removed vertex or one of its neighbors,
+	  in graphs created using Tombstones (but not LegacyUndirected), and Θ(V + E) otherwise
+	- Space: O(E)
+*/
 func (g *G) RemoveVertex(i Item) error {
 	iDel, ok := g.sat[i]
 
@@ -255,9 +508,36 @@ func (g *G) RemoveVertex(i Item) error {
 		return ErrNoVtx
 	}
 
+	if g.tomb {
+		g.bury(iDel)
+		return nil
+	}
+
+	// the edges of the vertex being removed take their
+	// reverse copies (or incoming index entries) with
+	// them, last to first, so no edge of the vertex
+	// is shifted meanwhile
+	if g.linked() || g.indexed() {
+		for e := len(g.V[iDel].E) - 1; e >= 0; e-- {
+			g.cutEdge(iDel, e)
+		}
+	}
+
+	// the same goes for the edges arriving at it
+	if g.indexed() {
+		for k := len(g.in[iDel]) - 1; k >= 0; k-- {
+			ref := g.in[iDel][k]
+			g.cutEdge(ref.src, ref.idx)
+		}
+	}
+
 	fixEdges := func() {
 		for v := range g.V {
 			if v == iDel {
+				for _, edge := range g.V[v].E {
+					g.eIDs.release(edge.ID.slot)
+				}
+
 				g.eCount -= len(g.V[v].E)
 				continue
 			}
@@ -275,6 +555,8 @@ func (g *G) RemoveVertex(i Item) error {
 				if edge.Dst == iDel {
 					remove = append(remove, e)
 					shifts++
+
+					g.eIDs.release(edge.ID.slot)
 					continue
 				}
 
@@ -297,16 +579,37 @@ func (g *G) RemoveVertex(i Item) error {
 				// edge by the number of edges that
 				// will be deleted so far
 				edge.Index -= shifts
+
+				if shifts != 0 {
+					g.trackEdge(v, edge)
+				}
 			}
 
-			for _, eIdx := range remove {
-				Cut(&g.V[v].E, eIdx)
+			// every removal shifts later edges to the
+			// left, which is relevant for multigraphs,
+			// where more than one edge can be removed
+			for i, eIdx := range remove {
+				Cut(&g.V[v].E, eIdx-i)
 				g.eCount--
 			}
 		}
 	}
 
 	deleteVertex := func() {
+		if g.indexed() {
+			Cut(&g.in, iDel)
+
+			for v := range g.in {
+				for k := range g.in[v] {
+					if g.in[v][k].src > iDel {
+						g.in[v][k].src--
+					}
+				}
+			}
+		}
+
+		g.vIDs.release(g.V[iDel].ID.slot)
+
 		Cut(&g.V, iDel)
 		delete(g.sat, i)
 		g.vCount--
@@ -316,6 +619,8 @@ func (g *G) RemoveVertex(i Item) error {
 		for i := iDel; i < len(g.V); i++ {
 			g.sat[g.V[i].Item] = i
 			g.V[i].Index = i
+
+			g.vIDs.slots[g.V[i].ID.slot].v = i
 		}
 	}
 
@@ -326,7 +631,554 @@ func (g *G) RemoveVertex(i Item) error {
 	return nil
 }
 
-// RemoveEdge removes the edge associated with the given Items.
+/*
+bury removes the edges incident on the vertex at the given index,
+and marks its slot as dead, without shifting any other vertex.
+*/
+func (g *G) bury(iDel int) {
+	// cutting the edges of the vertex (and their
+	// reverse copies or incoming index entries)
+	// last to first, so none of them is shifted
+	for e := len(g.V[iDel].E) - 1; e >= 0; e-- {
+		g.cutEdge(iDel, e)
+	}
+
+	switch {
+
+	case g.indexed():
+		for k := len(g.in[iDel]) - 1; k >= 0; k-- {
+			ref := g.in[iDel][k]
+			g.cutEdge(ref.src, ref.idx)
+		}
+
+	// the reverse copies of the edges of the vertex are already
+	// gone, so there are no more edges arriving at the vertex
+	case g.linked():
+
+	default:
+		for v := range g.V {
+			for e := len(g.V[v].E) - 1; e >= 0; e-- {
+				if g.V[v].E[e].Dst == iDel {
+					g.cutEdge(v, e)
+				}
+			}
+		}
+	}
+
+	g.vIDs.release(g.V[iDel].ID.slot)
+	delete(g.sat, g.V[iDel].Item)
+
+	g.V[iDel].E = nil
+	g.V[iDel].dead = true
+
+	g.holes++
+	g.vCount--
+}
+
+/*
+Compact reclaims the dead slots left in V by removed vertices, in a graph created using Tombstones,
+shifting later vertices into them, while keeping the relative order of the remaining vertices and
+edges, and their handles. Indexes held elsewhere become outdated, just like after RemoveVertices.
+Compacting a graph with no dead slots does nothing.
+
+Complexity:
+	- Time:  O(1) if there are no dead slots, and Θ(V + E) otherwise
+	- Space: O(1) if there are no dead slots, and Θ(V + E) otherwise
+*/
+func (g *G) Compact() {
+	if g.holes == 0 {
+		return
+	}
+
+	del := make([]bool, len(g.V))
+
+	for v := range g.V {
+		del[v] = g.V[v].dead
+	}
+
+	g.compact(del)
+}
+
+/*
+RemoveVertices removes the vertices associated with the given Items, along with any edges incident on them,
+in a single compaction pass over the graph, instead of one pass for each vertex: the remaining vertices and
+edges keep their relative order. If any of the Items has no vertex, the graph is left unchanged.
+
+The pass visits every vertex and edge of the graph, no matter how many vertices are removed, and whether
+or not the graph was created using InEdgeIndex, which only adds the cost of rebuilding the index. Removing
+a few vertices from a large graph is cheaper with SwapRemoveVertex, if the graph knows where the edges
+arriving at each vertex are, and the order of the vertices does not matter. Any dead slots of a graph
+created using Tombstones are reclaimed in the same pass (see Compact).
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func (g *G) RemoveVertices(is ...Item) error {
+	del := make([]bool, len(g.V))
+
+	for v := range g.V {
+		del[v] = g.V[v].dead
+	}
+
+	for _, i := range is {
+		v, ok := g.sat[i]
+
+		if !ok {
+			return ErrNoVtx
+		}
+
+		del[v] = true
+	}
+
+	g.compact(del)
+
+	return nil
+}
+
+// compact removes the vertices marked in del, along with any edges incident on them, in a single pass.
+func (g *G) compact(del []bool) {
+
+	// newIdx[v] is the index of v after the
+	// removal, or -1 if v is being removed
+	newIdx := make([]int, len(g.V))
+	count := 0
+
+	for v := range g.V {
+		if del[v] {
+			newIdx[v] = -1
+			continue
+		}
+
+		newIdx[v] = count
+		count++
+	}
+
+	// newPos[v][e] is the index of edge e of v after
+	// the removal, or -1 if the edge is being removed
+	newPos := make([][]int, len(g.V))
+
+	for v := range g.V {
+		newPos[v] = make([]int, len(g.V[v].E))
+		k := 0
+
+		for e, edge := range g.V[v].E {
+			if del[v] || del[edge.Dst] {
+				newPos[v][e] = -1
+				continue
+			}
+
+			newPos[v][e] = k
+			k++
+		}
+	}
+
+	copies := 0
+
+	for v := range g.V {
+		es := g.V[v].E[:0]
+
+		for e, edge := range g.V[v].E {
+			s := &g.eIDs.slots[edge.ID.slot]
+			tracked := s.v == g.V[v].ID.slot
+
+			if newPos[v][e] == -1 {
+				if tracked {
+					g.eIDs.release(edge.ID.slot)
+				}
+
+				continue
+			}
+
+			if g.linked() {
+				edge.Rev = newPos[edge.Dst][edge.Rev]
+			}
+
+			edge.Src = newIdx[edge.Src]
+			edge.Dst = newIdx[edge.Dst]
+			edge.Index = newPos[v][e]
+
+			if tracked {
+				s.e = edge.Index
+			}
+
+			es = append(es, edge)
+		}
+
+		// avoiding memory leaks in the unused positions
+		for e := len(es); e < len(g.V[v].E); e++ {
+			g.V[v].E[e] = GE{}
+		}
+
+		g.V[v].E = es
+		copies += len(es)
+	}
+
+	if g.indexed() {
+		in := make([][]inRef, 0, count)
+
+		for v := range g.in {
+			if del[v] {
+				continue
+			}
+
+			refs := []inRef{}
+
+			for _, ref := range g.in[v] {
+				if del[ref.src] {
+					continue
+				}
+
+				refs = append(refs, inRef{newIdx[ref.src], newPos[ref.src][ref.idx]})
+			}
+
+			in = append(in, refs)
+		}
+
+		g.in = in
+	}
+
+	vs := g.V[:0]
+
+	for v, gv := range g.V {
+		// dead slots were already released,
+		// and their Items may have been added
+		// again, as different vertices
+		if del[v] && !gv.dead {
+			g.vIDs.release(gv.ID.slot)
+			delete(g.sat, gv.Item)
+		}
+
+		if del[v] {
+			continue
+		}
+
+		gv.Index = newIdx[v]
+
+		g.sat[gv.Item] = gv.Index
+		g.vIDs.slots[gv.ID.slot].v = gv.Index
+
+		vs = append(vs, gv)
+	}
+
+	for v := len(vs); v < len(g.V); v++ {
+		g.V[v] = GV{}
+	}
+
+	g.V = vs
+	g.vCount = count
+	g.holes = 0
+
+	if g.indexed() {
+		for v := range g.in {
+			for k, ref := range g.in[v] {
+				g.V[ref.src].E[ref.idx].Rev = k
+			}
+		}
+	}
+
+	if g.linked() {
+		g.eCount = copies / 2
+	} else {
+		g.eCount = copies
+	}
+}
+
+/*
+SwapRemoveVertex removes the vertex associated with the given Item, along with any edges incident on it,
+and then moves the last vertex of the graph to the position left by the removed vertex, instead of shifting
+every later vertex. Only the last vertex has its index changed, which makes the removal much cheaper,
+at the cost of not preserving the insertion order of the vertices.
+
+Undirected graphs, and directed graphs created using InEdgeIndex, know where the edges arriving at each
+vertex are, so the removal takes time proportional to the degrees of the removed and moved vertices
+(and of their neighbors). Other directed graphs need to look for these edges in every adjacency list,
+twice: once for the removed vertex, and once for the moved one, which makes each removal Θ(V + E),
+and removing k vertices one at a time Θ(k(V + E)), slower than a single call to RemoveVertices.
+Graphs created using Tombstones are compacted first, if they have any dead slots (see Compact).
+
+Complexity:
+	- Time:  O(Σ deg(u)), for every u that is the removed vertex, the moved vertex, or one of their
+	  neighbors, in undirected graphs and graphs created using InEdgeIndex, and Θ(V + E) otherwise
+	- Space: O(1)
+*/
+func (g *G) SwapRemoveVertex(i Item) error {
+	if _, ok := g.sat[i]; !ok {
+		return ErrNoVtx
+	}
+
+	g.Compact()
+
+	iDel := g.sat[i]
+
+	for e := len(g.V[iDel].E) - 1; e >= 0; e-- {
+		g.cutEdge(iDel, e)
+	}
+
+	switch {
+
+	case g.indexed():
+		for k := len(g.in[iDel]) - 1; k >= 0; k-- {
+			ref := g.in[iDel][k]
+			g.cutEdge(ref.src, ref.idx)
+		}
+
+	// the reverse copies of the edges of the vertex are already
+	// gone, so there are no more edges arriving at the vertex
+	case g.linked():
+
+	default:
+		for v := range g.V {
+			for e := len(g.V[v].E) - 1; e >= 0; e-- {
+				if g.V[v].E[e].Dst == iDel {
+					g.cutEdge(v, e)
+				}
+			}
+		}
+	}
+
+	last := len(g.V) - 1
+
+	if iDel != last {
+		g.moveLast(iDel)
+	}
+
+	g.vIDs.release(g.V[iDel].ID.slot)
+	delete(g.sat, i)
+
+	if iDel != last {
+		g.V[iDel] = g.V[last]
+		g.V[iDel].Index = iDel
+
+		g.sat[g.V[iDel].Item] = iDel
+		g.vIDs.slots[g.V[iDel].ID.slot].v = iDel
+
+		if g.indexed() {
+			g.in[iDel] = g.in[last]
+		}
+	}
+
+	g.V[last] = GV{}
+	g.V = g.V[:last]
+
+	if g.indexed() {
+		g.in[last] = nil
+		g.in = g.in[:last]
+	}
+
+	g.vCount--
+
+	return nil
+}
+
+// moveLast changes every reference to the last vertex of the graph, so it can be moved to the given index.
+func (g *G) moveLast(to int) {
+	last := len(g.V) - 1
+
+	switch {
+
+	case g.indexed():
+		for _, ref := range g.in[last] {
+			g.V[ref.src].E[ref.idx].Dst = to
+		}
+
+		for _, e := range g.V[last].E {
+			dst := e.Dst
+
+			// a loop, whose destination was just changed,
+			// since every edge arriving at the removed
+			// vertex is already gone
+			if dst == to {
+				dst = last
+			}
+
+			g.in[dst][e.Rev].src = to
+		}
+
+	case g.linked():
+		for _, e := range g.V[last].E {
+			g.V[e.Dst].E[e.Rev].Dst = to
+		}
+
+	default:
+		for v := range g.V {
+			for e := range g.V[v].E {
+				if g.V[v].E[e].Dst == last {
+					g.V[v].E[e].Dst = to
+				}
+			}
+		}
+	}
+
+	for e := range g.V[last].E {
+		g.V[last].E[e].Src = to
+	}
+}
+
+/*
+cutEdge removes the edge at index e of the adjacency list of v, fixing the index of later edges.
+If the graph keeps linked copies of its edges, the reverse copy of the edge is removed as well.
+*/
+func (g *G) cutEdge(v, e int) {
+	edge := g.V[v].E[e]
+
+	g.cutCopy(v, e)
+
+	if g.linked() {
+		g.cutCopy(edge.Dst, edge.Rev)
+	}
+
+	g.eIDs.release(edge.ID.slot)
+	g.eCount--
+}
+
+// cutCopy removes a single copy of an edge, fixing the index of later copies, and any links to them.
+func (g *G) cutCopy(v, e int) {
+	if g.indexed() {
+		g.cutIn(g.V[v].E[e])
+	}
+
+	Cut(&g.V[v].E, e)
+
+	for i := e; i < len(g.V[v].E); i++ {
+		edge := &g.V[v].E[i]
+		edge.Index--
+
+		g.trackEdge(v, edge)
+
+		if g.linked() {
+			g.V[edge.Dst].E[edge.Rev].Rev = edge.Index
+		}
+
+		if g.indexed() {
+			g.in[edge.Dst][edge.Rev].idx = edge.Index
+		}
+	}
+}
+
+// cutIn removes an edge from the index of incoming edges, fixing the position of later incoming edges.
+func (g *G) cutIn(edge GE) {
+	Cut(&g.in[edge.Dst], edge.Rev)
+
+	for k := edge.Rev; k < len(g.in[edge.Dst]); k++ {
+		ref := g.in[edge.Dst][k]
+		g.V[ref.src].E[ref.idx].Rev = k
+	}
+}
+
+// OutDegree calculates the number of edges leaving the vertex at the given index, in O(1) time.
+func (g *G) OutDegree(v int) int {
+	return len(g.V[v].E)
+}
+
+/*
+InDegree calculates the number of edges arriving at the vertex at the given index. This takes O(1) time
+for undirected graphs and for directed graphs created using InEdgeIndex, and Θ(V + E) time otherwise.
+*/
+func (g *G) InDegree(v int) int {
+	if g.linked() {
+		return len(g.V[v].E)
+	}
+
+	if g.indexed() {
+		return len(g.in[v])
+	}
+
+	count := 0
+
+	for u := range g.V {
+		for _, e := range g.V[u].E {
+			if e.Dst == v {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+/*
+InEdges retrieves the edges arriving at the vertex at the given index. In an undirected graph, these are
+the reverse copies of the edges leaving the vertex, in the same order. In a directed graph created using
+InEdgeIndex, edges are retrieved in time proportional to their number, in the order they were added,
+while other graphs need to check every edge, in Θ(V + E) time, listing edges by source vertex.
+*/
+func (g *G) InEdges(v int) []GE {
+	res := []GE{}
+
+	if g.linked() {
+		for _, e := range g.V[v].E {
+			res = append(res, g.V[e.Dst].E[e.Rev])
+		}
+
+		return res
+	}
+
+	if g.indexed() {
+		for _, ref := range g.in[v] {
+			res = append(res, g.V[ref.src].E[ref.idx])
+		}
+
+		return res
+	}
+
+	for u := range g.V {
+		for _, e := range g.V[u].E {
+			if e.Dst == v {
+				res = append(res, e)
+			}
+		}
+	}
+
+	return res
+}
+
+/*
+ReverseEdge retrieves the index(es) of the reverse copy of the edge at index e of the adjacency list
+of v, in an undirected graph. Linked copies are found in O(1) time, while graphs created using
+LegacyUndirected need to search for a copy with the same weight, in O(degree) time.
+*/
+func (g *G) ReverseEdge(v, e int) (int, int, bool) {
+	if g.Directed() {
+		return 0, 0, false
+	}
+
+	edge := g.V[v].E[e]
+
+	if g.linked() {
+		return edge.Dst, edge.Rev, true
+	}
+
+	for i, rev := range g.V[edge.Dst].E {
+		if rev.Dst == v && rev.Wt == edge.Wt {
+			return edge.Dst, i, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// VertexFmt retrieves the formatting attributes of the vertex at the given index, so they can be changed.
+func (g *G) VertexFmt(v int) *Formattable {
+	return &g.V[v].Formattable
+}
+
+/*
+EdgeFmt retrieves the formatting attributes of the edge at index e of the adjacency list of v,
+so they can be changed, or nil if there is no such edge.
+*/
+func (g *G) EdgeFmt(v, e int) *Formattable {
+	if e < 0 || e >= len(g.V[v].E) {
+		return nil
+	}
+
+	return &g.V[v].E[e].Formattable
+}
+
+/*
+RemoveEdge removes the edge associated with the given Items.
+In a multigraph, the first of any parallel edges is the one removed.
+*/
 func (g *G) RemoveEdge(src Item, dst Item) error {
 	vIdx, idx, ok := g.EdgeIndex(src, dst)
 
@@ -334,17 +1186,235 @@ func (g *G) RemoveEdge(src Item, dst Item) error {
 		return ErrNoEdge
 	}
 
-	Cut(&g.V[vIdx].E, idx)
+	g.cutEdge(vIdx, idx)
+
+	return nil
+}
+
+/*
+RemoveEdgeAt removes a specific edge, given the index of its source vertex and its index
+in the adjacency list of that vertex, which is how parallel edges can be told apart.
+*/
+func (g *G) RemoveEdgeAt(v int, e int) error {
+	if v < 0 || v >= len(g.V) {
+		return ErrNoVtx
+	}
+
+	if e < 0 || e >= len(g.V[v].E) {
+		return ErrNoEdge
+	}
+
+	g.cutEdge(v, e)
+
+	return nil
+}
+
+// RemoveEdges removes every edge associated with the given Items, returning how many were removed.
+func (g *G) RemoveEdges(src Item, dst Item) (int, error) {
+	iSrc, ok := g.VertexIndex(src)
+
+	if !ok {
+		return 0, ErrNoEdge
+	}
+
+	iDst, ok := g.VertexIndex(dst)
 
-	for i := idx; i < len(g.V[vIdx].E); i++ {
-		g.V[vIdx].E[i].Index--
+	if !ok {
+		return 0, ErrNoEdge
 	}
 
-	g.eCount--
+	count := 0
+
+	for e := 0; e < len(g.V[iSrc].E); {
+		if g.V[iSrc].E[e].Dst != iDst {
+			e++
+			continue
+		}
+
+		g.cutEdge(iSrc, e)
+		count++
+	}
+
+	if count == 0 {
+		return 0, ErrNoEdge
+	}
+
+	return count, nil
+}
+
+/*
+wtConflict is the error returned by symmetrize when two copies of an undirected edge that would be linked
+have different weights: the copy at position e in the adjacency list of v, and the copy at position r
+in the adjacency list of u.
+*/
+type wtConflict struct {
+	v, e int
+	u, r int
+}
+
+func (c wtConflict) Error() string {
+	return ErrWtConflict.Error()
+}
+
+func (c wtConflict) Unwrap() error {
+	return ErrWtConflict
+}
+
+/*
+symmetrize turns an undirected graph created using LegacyUndirected into one that keeps linked copies
+of its edges: the k-th copy of (u, v) in the adjacency list of u is linked to the k-th copy of (v, u)
+in the adjacency list of v, and any copy without a counterpart gets its reverse copy appended.
+
+If two copies that would be linked have different weights, a wtConflict is returned,
+and the graph is left in an unspecified state, so it should be discarded.
+*/
+func (g *G) symmetrize() error {
+	if g.dir || !g.legacy {
+		return nil
+	}
+
+	// unpaired[v][u] holds the positions of the copies of (v, u)
+	// that were not yet linked, in adjacency list order
+	unpaired := make([]map[int][]int, len(g.V))
+
+	for v := range g.V {
+		unpaired[v] = map[int][]int{}
+
+		for e, edge := range g.V[v].E {
+			unpaired[v][edge.Dst] = append(unpaired[v][edge.Dst], e)
+		}
+	}
+
+	count := 0
+
+	for v := range g.V {
+		for e := 0; e < len(g.V[v].E); e++ {
+			queue := unpaired[v][g.V[v].E[e].Dst]
+
+			// already linked as the reverse copy of an earlier edge
+			if len(queue) == 0 || queue[0] != e {
+				continue
+			}
+
+			unpaired[v][g.V[v].E[e].Dst] = queue[1:]
+
+			u := g.V[v].E[e].Dst
+			rev := unpaired[u][v]
+
+			var r int
+
+			if len(rev) != 0 {
+				r = rev[0]
+				unpaired[u][v] = rev[1:]
+
+				if g.V[u].E[r].Wt != g.V[v].E[e].Wt {
+					return wtConflict{v, e, u, r}
+				}
+
+				// both copies share the handle of the first one
+				g.eIDs.release(g.V[u].E[r].ID.slot)
+				g.V[u].E[r].ID = g.V[v].E[e].ID
+			} else {
+				g.V[u].E = append(
+					g.V[u].E,
+					GE{
+						Index: len(g.V[u].E),
+						Src:   u,
+						Dst:   v,
+						Wt:    g.V[v].E[e].Wt,
+						ID:    g.V[v].E[e].ID,
+					},
+				)
+
+				r = len(g.V[u].E) - 1
+			}
+
+			g.V[v].E[e].Rev = r
+			g.V[u].E[r].Rev = e
+
+			count++
+		}
+	}
+
+	g.eCount = count
+	g.legacy = false
 
 	return nil
 }
 
+// clone creates a deep copy of the graph, with the formatting attributes of its vertices and edges copied only if withFmt is set.
+func (g *G) clone(withFmt bool) *G {
+	res := &G{}
+
+	*res = *g
+
+	res.V = make([]GV, len(g.V))
+
+	for v := range g.V {
+		res.V[v] = g.V[v]
+		res.V[v].Formattable = Formattable{}
+		res.V[v].E = make([]GE, len(g.V[v].E))
+
+		if withFmt {
+			res.V[v].Formattable = g.V[v].cloneFmt()
+		}
+
+		for e := range g.V[v].E {
+			res.V[v].E[e] = g.V[v].E[e]
+			res.V[v].E[e].Formattable = Formattable{}
+
+			if withFmt {
+				res.V[v].E[e].Formattable = g.V[v].E[e].cloneFmt()
+			}
+		}
+	}
+
+	res.sat = make(map[Item]int, len(g.sat))
+
+	for i, v := range g.sat {
+		res.sat[i] = v
+	}
+
+	res.vIDs = g.vIDs.clone()
+	res.eIDs = g.eIDs.clone()
+
+	if g.in != nil {
+		res.in = make([][]inRef, len(g.in))
+
+		for v := range g.in {
+			res.in[v] = append([]inRef(nil), g.in[v]...)
+		}
+	}
+
+	return res
+}
+
+/*
+Clone creates a deep copy of the graph, which can be modified without affecting the original one:
+vertices, edges, weights and the formatting attributes of vertices and edges are all copied,
+while Items are shared with the original graph. The copy keeps the options of the original
+graph, and the handles of its vertices and edges, which are valid in both graphs (see VertexID).
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func (g *G) Clone() *G {
+	return g.clone(true)
+}
+
+/*
+CloneStructure creates a deep copy of the graph, just like Clone, but without copying
+the formatting attributes of its vertices and edges, which start out unformatted.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func (g *G) CloneStructure() *G {
+	return g.clone(false)
+}
+
 // Accept accepts a graph visitor, and guides its execution using double-dispatching.
 func (g G) Accept(vis GraphVisitor) {
 	vis.VisitGraphStart(g)
@@ -360,26 +1430,62 @@ func (g G) Accept(vis GraphVisitor) {
 	vis.VisitGraphEnd(g)
 }
 
+// Walk guides the execution of a Visitor over every vertex and edge of the graph, in order.
+func (g *G) Walk(vis Visitor) {
+	walk(g, vis, func(v int) GV {
+		return g.V[v]
+	})
+}
+
+// optsOf retrieves the options needed to create a graph that behaves like the given one.
+func optsOf(g Graph) []GraphOpt {
+	opts := []GraphOpt{}
+
+	mg, ok := g.(*G)
+
+	if !ok {
+		return opts
+	}
+
+	if mg.multi {
+		opts = append(opts, Multigraph())
+	}
+
+	if mg.inIdx {
+		opts = append(opts, InEdgeIndex())
+	}
+
+	if mg.legacy {
+		opts = append(opts, LegacyUndirected())
+	}
+
+	if mg.tomb {
+		opts = append(opts, Tombstones())
+	}
+
+	return opts
+}
+
 // Transpose creates a transpose graph for a directed graph, where all original edges are reversed.
-func Transpose(g *G) (*G, error) {
+func Transpose(g Graph) (*G, error) {
 	if g.Undirected() {
 		return nil, ErrUndirected
 	}
 
-	res := NewDigraph()
+	res := NewDigraphWith(optsOf(g)...)
 
-	for v := range g.V {
-		res.AddVertex(g.V[v].Item)
+	for v := 0; v < g.VertexCount(); v++ {
+		res.AddVertex(g.Item(v))
 	}
 
-	for v := range g.V {
-		for _, edge := range g.V[v].E {
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(edge GE) {
 			res.AddEdge(
-				g.V[edge.Dst].Item,
-				g.V[edge.Src].Item,
+				g.Item(edge.Dst),
+				g.Item(edge.Src),
 				edge.Wt,
 			)
-		}
+		})
 	}
 
 	return res, nil
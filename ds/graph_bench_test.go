@@ -0,0 +1,84 @@
+package ds
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func removalBenchGen(n int, opts ...GraphOpt) (*G, []Item) {
+	g := NewDigraphWith(opts...)
+	items := make([]Item, n)
+
+	for v := range items {
+		t := Text(strconv.Itoa(v))
+		items[v] = &t
+
+		g.AddVertex(items[v])
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	for v := range items {
+		for k := 0; k < 8; k++ {
+			g.AddEdge(items[v], items[r.Intn(n)], 0)
+		}
+	}
+
+	return g, items
+}
+
+func BenchmarkRemoval(b *testing.B) {
+	modes := []struct {
+		desc string
+		opts []GraphOpt
+	}{
+		{"plain", nil},
+		{"indexed", []GraphOpt{InEdgeIndex()}},
+		{"tombstones", []GraphOpt{Tombstones()}},
+	}
+
+	for _, size := range []int{256, 4096} {
+		for _, mode := range modes {
+			g, items := removalBenchGen(size, mode.opts...)
+
+			// removing a tenth of the vertices
+			del := items[:size/10]
+
+			b.Run(fmt.Sprintf("swap-%s-%d", mode.desc, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					c := g.Clone()
+					b.StartTimer()
+
+					for _, it := range del {
+						c.SwapRemoveVertex(it)
+					}
+				}
+			})
+
+			b.Run(fmt.Sprintf("batch-%s-%d", mode.desc, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					c := g.Clone()
+					b.StartTimer()
+
+					c.RemoveVertices(del...)
+				}
+			})
+
+			b.Run(fmt.Sprintf("single-%s-%d", mode.desc, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					c := g.Clone()
+					b.StartTimer()
+
+					for _, it := range del {
+						c.RemoveVertex(it)
+					}
+				}
+			})
+		}
+	}
+}
@@ -2,6 +2,8 @@ package ds
 
 import (
 	"errors"
+	"math/rand"
+	"strconv"
 	"testing"
 
 	ut "github.com/vc-souza/gga/internal/testutils"
@@ -31,6 +33,13 @@ func (c *counterGraphVisitor) VisitGraphEnd(G)   { c.gCalls++ }
 func (c *counterGraphVisitor) VisitVertex(G, GV) { c.vCalls++ }
 func (c *counterGraphVisitor) VisitEdge(G, GE)   { c.eCalls++ }
 
+type counterVisitor struct{ gCalls, vCalls, eCalls int }
+
+func (c *counterVisitor) VisitStart(Graph)  { c.gCalls++ }
+func (c *counterVisitor) VisitEnd(Graph)    { c.gCalls++ }
+func (c *counterVisitor) VisitGV(Graph, GV) { c.vCalls++ }
+func (c *counterVisitor) VisitGE(Graph, GE) { c.eCalls++ }
+
 func tagGraphTest(gType, desc string) string {
 	return gType + " " + desc
 }
@@ -49,7 +58,8 @@ func addEdges(t *testing.T, g *G, es ...edge) {
 		_, _, err = g.AddEdge(e.src, e.dst, e.wt)
 		ut.Nil(t, err)
 
-		if g.Directed() {
+		// only legacy undirected graphs need the reverse copy
+		if g.Directed() || g.linked() {
 			continue
 		}
 
@@ -190,11 +200,7 @@ func TestGEdgeCount(t *testing.T) {
 				addVerts(t, g, tc.verts...)
 				addEdges(t, g, tc.edges...)
 
-				if g.Undirected() {
-					ut.Equal(t, tc.expect*2, g.EdgeCount())
-				} else {
-					ut.Equal(t, tc.expect, g.EdgeCount())
-				}
+				ut.Equal(t, tc.expect, g.EdgeCount())
 			})
 		}
 	}
@@ -516,11 +522,7 @@ func TestGRemoveEdge(t *testing.T) {
 			err = g.RemoveEdge(vA, vB)
 			ut.Nil(t, err)
 
-			if g.Directed() {
-				ut.Equal(t, 2, g.EdgeCount())
-			} else {
-				ut.Equal(t, 5, g.EdgeCount())
-			}
+			ut.Equal(t, 2, g.EdgeCount())
 
 			iV, iE, ok := g.EdgeIndex(vA, vC)
 
@@ -537,6 +539,403 @@ func TestGRemoveEdge(t *testing.T) {
 	}
 }
 
+func TestGMultigraph(t *testing.T) {
+	g := NewDigraphWith(Multigraph())
+
+	ut.True(t, g.IsMultigraph())
+	ut.False(t, NewDigraph().IsMultigraph())
+
+	addVerts(t, g, vA, vB, vC)
+
+	_, e1, err := g.AddEdge(vA, vB, 3)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, e1)
+
+	_, e2, err := g.AddEdge(vA, vB, 1)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, e2)
+
+	_, _, err = g.AddEdge(vA, vC, 2)
+
+	ut.Nil(t, err)
+
+	_, e3, err := g.AddEdge(vA, vB, 2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 3, e3)
+	ut.Equal(t, 4, g.EdgeCount())
+
+	// the first parallel edge is the one found
+	iV, iE, ok := g.EdgeIndex(vA, vB)
+
+	ut.True(t, ok)
+	ut.Equal(t, 0, iV)
+	ut.Equal(t, 0, iE)
+
+	ut.Nil(t, g.RemoveEdgeAt(0, 1))
+	ut.Equal(t, 3, g.EdgeCount())
+
+	for i, e := range g.V[0].E {
+		ut.Equal(t, i, e.Index)
+	}
+
+	ut.Equal(t, 3.0, g.V[0].E[0].Wt)
+	ut.Equal(t, 2.0, g.V[0].E[2].Wt)
+
+	ut.True(t, errors.Is(g.RemoveEdgeAt(0, 3), ErrNoEdge))
+	ut.True(t, errors.Is(g.RemoveEdgeAt(3, 0), ErrNoVtx))
+
+	count, err := g.RemoveEdges(vA, vB)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 2, count)
+	ut.Equal(t, 1, g.EdgeCount())
+	ut.Equal(t, 0, g.V[0].E[0].Index)
+	ut.Equal(t, 2, g.V[0].E[0].Dst)
+
+	_, err = g.RemoveEdges(vA, vB)
+
+	ut.True(t, errors.Is(err, ErrNoEdge))
+}
+
+func TestGMultigraph_remove_vertex(t *testing.T) {
+	g := NewDigraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB, vC)
+
+	g.AddEdge(vA, vB, 1)
+	g.AddEdge(vA, vC, 2)
+	g.AddEdge(vA, vB, 3)
+	g.AddEdge(vA, vC, 4)
+	g.AddEdge(vA, vB, 5)
+
+	ut.Nil(t, g.RemoveVertex(vB))
+	ut.Equal(t, 2, g.EdgeCount())
+	ut.Equal(t, 2, len(g.V[0].E))
+
+	for i, e := range g.V[0].E {
+		ut.Equal(t, i, e.Index)
+		ut.Equal(t, 1, e.Dst)
+		ut.Equal(t, float64(2*(i+1)), e.Wt)
+	}
+}
+
+// checkLinks checks that every copy of an undirected edge is linked to its reverse copy.
+func checkLinks(t *testing.T, g *G) {
+	count := 0
+
+	for v := range g.V {
+		for e, edge := range g.V[v].E {
+			ut.Equal(t, e, edge.Index)
+
+			rev := g.V[edge.Dst].E[edge.Rev]
+
+			ut.Equal(t, v, rev.Dst)
+			ut.Equal(t, e, rev.Rev)
+			ut.Equal(t, edge.Wt, rev.Wt)
+
+			count++
+		}
+	}
+
+	ut.Equal(t, count, 2*g.EdgeCount())
+}
+
+func TestGUndirected_links(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB, vC, vD)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vC, vA, 2},
+		{vB, vC, 3},
+		{vD, vA, 4},
+	}...)
+
+	ut.Equal(t, 4, g.EdgeCount())
+	checkLinks(t, g)
+
+	// the reverse copy is appended to the list of the destination
+	iV, iE, ok := g.EdgeIndex(vA, vC)
+
+	ut.True(t, ok)
+	ut.Equal(t, 0, iV)
+	ut.Equal(t, 1, iE)
+
+	_, _, err := g.AddEdge(vB, vA, 1)
+
+	ut.NotNil(t, err)
+	ut.True(t, errors.Is(err, ErrExists))
+
+	iV, iE, ok = g.ReverseEdge(0, 1)
+
+	ut.True(t, ok)
+	ut.Equal(t, 2, iV)
+	ut.Equal(t, 0, iE)
+
+	// removing either copy removes both
+	ut.Nil(t, g.RemoveEdge(vC, vA))
+
+	ut.Equal(t, 3, g.EdgeCount())
+	checkLinks(t, g)
+
+	_, _, ok = g.EdgeIndex(vA, vC)
+	ut.False(t, ok)
+
+	ut.Nil(t, g.RemoveEdgeAt(0, 0))
+
+	ut.Equal(t, 2, g.EdgeCount())
+	checkLinks(t, g)
+
+	_, _, ok = g.EdgeIndex(vB, vA)
+	ut.False(t, ok)
+
+	ut.Nil(t, g.RemoveVertex(vC))
+
+	ut.Equal(t, 1, g.EdgeCount())
+	checkLinks(t, g)
+
+	ut.Equal(t, 0, len(g.V[1].E))
+}
+
+func TestGUndirected_multigraph(t *testing.T) {
+	g := NewGraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vB, vA, 2},
+		{vA, vC, 3},
+		{vA, vB, 4},
+		{vC, vB, 5},
+	}...)
+
+	ut.Equal(t, 5, g.EdgeCount())
+	checkLinks(t, g)
+
+	ut.Nil(t, g.RemoveEdgeAt(1, 1))
+
+	ut.Equal(t, 4, g.EdgeCount())
+	checkLinks(t, g)
+
+	for _, e := range g.V[0].E {
+		ut.True(t, e.Wt != 2)
+	}
+
+	count, err := g.RemoveEdges(vB, vA)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 2, count)
+	ut.Equal(t, 2, g.EdgeCount())
+	checkLinks(t, g)
+
+	ut.Nil(t, g.RemoveVertex(vA))
+
+	ut.Equal(t, 1, g.EdgeCount())
+	checkLinks(t, g)
+}
+
+func TestGLegacyUndirected(t *testing.T) {
+	g := NewGraphWith(LegacyUndirected())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vA, vC, 2},
+	}...)
+
+	// both copies are added by hand, and counted
+	ut.Equal(t, 4, g.EdgeCount())
+
+	iV, iE, ok := g.ReverseEdge(0, 1)
+
+	ut.True(t, ok)
+	ut.Equal(t, 2, iV)
+	ut.Equal(t, 0, iE)
+
+	ut.Nil(t, g.RemoveEdge(vA, vB))
+
+	ut.Equal(t, 3, g.EdgeCount())
+
+	_, _, ok = g.EdgeIndex(vB, vA)
+	ut.True(t, ok)
+}
+
+func TestGSymmetrize_conflict(t *testing.T) {
+	g := NewGraphWith(LegacyUndirected())
+
+	addVerts(t, g, vA, vB, vC)
+
+	g.AddEdge(vA, vB, 1)
+	g.AddEdge(vA, vC, 2)
+	g.AddEdge(vC, vA, 2)
+	g.AddEdge(vB, vA, 3)
+
+	err := g.symmetrize()
+
+	var c wtConflict
+
+	ut.True(t, errors.As(err, &c))
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	ut.Equal(t, wtConflict{0, 0, 1, 0}, c)
+}
+
+func TestGReverseEdge_directed(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB)
+	addEdges(t, g, []edge{{vA, vB, 0}, {vB, vA, 0}}...)
+
+	_, _, ok := g.ReverseEdge(0, 0)
+	ut.False(t, ok)
+}
+
+// checkInEdges checks the index of incoming edges of a graph against every one of its edges.
+func checkInEdges(t *testing.T, g *G) {
+	count := 0
+
+	for v := range g.V {
+		in := g.InEdges(v)
+
+		ut.Equal(t, len(in), g.InDegree(v))
+		ut.Equal(t, len(g.V[v].E), g.OutDegree(v))
+
+		for k, e := range in {
+			ut.Equal(t, v, e.Dst)
+			ut.Equal(t, k, e.Rev)
+
+			// the edge is the one in the adjacency list of its source
+			orig := g.V[e.Src].E[e.Index]
+
+			ut.Equal(t, v, orig.Dst)
+			ut.Equal(t, k, orig.Rev)
+			ut.Equal(t, e.Wt, orig.Wt)
+		}
+
+		count += len(in)
+	}
+
+	ut.Equal(t, g.EdgeCount(), count)
+}
+
+func TestGInEdges(t *testing.T) {
+	g := NewDigraphWith(InEdgeIndex())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vC, 1},
+		{vB, vC, 2},
+		{vC, vA, 3},
+		{vA, vB, 4},
+		{vC, vC, 5},
+	}...)
+
+	checkInEdges(t, g)
+
+	in := g.InEdges(2)
+
+	ut.Equal(t, 3, len(in))
+	ut.Equal(t, 1.0, in[0].Wt)
+	ut.Equal(t, 2.0, in[1].Wt)
+	ut.Equal(t, 5.0, in[2].Wt)
+
+	ut.Equal(t, 3, g.InDegree(2))
+	ut.Equal(t, 2, g.OutDegree(0))
+
+	ut.Nil(t, g.RemoveEdge(vA, vC))
+	checkInEdges(t, g)
+
+	ut.Equal(t, 2, g.InDegree(2))
+
+	ut.Nil(t, g.RemoveVertex(vA))
+	checkInEdges(t, g)
+
+	ut.Equal(t, 2, g.InDegree(1))
+	ut.Equal(t, 0, g.InDegree(0))
+}
+
+func TestGInEdges_no_index(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC)
+			addEdges(t, g, []edge{
+				{vA, vC, 1},
+				{vB, vC, 2},
+				{vA, vB, 3},
+			}...)
+
+			in := g.InEdges(2)
+
+			ut.Equal(t, 2, len(in))
+			ut.Equal(t, 2, g.InDegree(2))
+
+			for _, e := range in {
+				ut.Equal(t, 2, e.Dst)
+			}
+
+			if g.Directed() {
+				ut.Equal(t, 0, g.InDegree(0))
+			} else {
+				ut.Equal(t, 2, g.InDegree(0))
+			}
+		})
+	}
+}
+
+// TestGInEdges_random applies a random sequence of operations to an indexed multigraph.
+func TestGInEdges_random(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+
+	g := NewDigraphWith(InEdgeIndex(), Multigraph())
+
+	item := func() Item {
+		return Text(strconv.Itoa(r.Intn(20)))
+	}
+
+	for i := 0; i < 2000; i++ {
+		switch op := r.Intn(10); {
+		case op < 2:
+			g.AddVertex(item())
+
+		case op < 7:
+			g.AddEdge(item(), item(), float64(r.Intn(10)))
+
+		case op < 8:
+			if v, ok := g.VertexIndex(item()); ok && len(g.V[v].E) != 0 {
+				ut.Nil(t, g.RemoveEdgeAt(v, r.Intn(len(g.V[v].E))))
+			}
+
+		case op < 9:
+			g.RemoveEdges(item(), item())
+
+		default:
+			g.RemoveVertex(item())
+		}
+
+		checkInEdges(t, g)
+	}
+}
+
+func TestTranspose_multigraph(t *testing.T) {
+	g := NewDigraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB)
+
+	g.AddEdge(vA, vB, 1)
+	g.AddEdge(vA, vB, 2)
+
+	tp, err := Transpose(g)
+
+	ut.Nil(t, err)
+	ut.True(t, tp.IsMultigraph())
+	ut.Equal(t, 2, tp.EdgeCount())
+}
+
 func TestTranspose_undirected(t *testing.T) {
 	g := NewGraph()
 
@@ -582,3 +981,494 @@ func TestTranspose_directed(t *testing.T) {
 	_, _, ok = tp.EdgeIndex(vD, vA)
 	ut.True(t, ok)
 }
+
+func TestGRemoveVertices(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1, g2 := gen(), gen()
+
+			for _, g := range []*G{g1, g2} {
+				addVerts(t, g, vA, vB, vC, vD, vE)
+				addEdges(t, g, []edge{
+					{vA, vB, 1},
+					{vB, vC, 2},
+					{vC, vD, 3},
+					{vD, vE, 4},
+					{vE, vA, 5},
+					{vA, vD, 6},
+				}...)
+			}
+
+			ut.Nil(t, g1.RemoveVertices(vB, vD, vB))
+
+			ut.Nil(t, g2.RemoveVertex(vB))
+			ut.Nil(t, g2.RemoveVertex(vD))
+
+			ut.Equal(t, g2.String(), g1.String())
+			ut.Equal(t, 3, g1.VertexCount())
+			ut.Equal(t, 1, g1.EdgeCount())
+
+			if g1.Undirected() {
+				checkLinks(t, g1)
+			}
+		})
+	}
+}
+
+func TestGRemoveVertices_no_vertex(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB)
+	addEdges(t, g, edge{vA, vB, 0})
+
+	err := g.RemoveVertices(vA, vC)
+
+	ut.True(t, errors.Is(err, ErrNoVtx))
+	ut.Equal(t, 2, g.VertexCount())
+	ut.Equal(t, 1, g.EdgeCount())
+}
+
+func TestGSwapRemoveVertex(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vB, vC, 2},
+				{vD, vC, 3},
+				{vD, vA, 4},
+			}...)
+
+			idD := g.V[3].ID
+
+			ut.Nil(t, g.SwapRemoveVertex(vB))
+			ut.True(t, errors.Is(g.SwapRemoveVertex(vB), ErrNoVtx))
+
+			// the last vertex took the place of the removed one
+			ut.True(t, g.V[1].Item == vD)
+			ut.Equal(t, 1, g.V[1].Index)
+
+			v, ok := g.VertexByID(idD)
+
+			ut.True(t, ok)
+			ut.Equal(t, 1, v)
+
+			ut.Equal(t, 3, g.VertexCount())
+			ut.Equal(t, 2, g.EdgeCount())
+
+			_, _, ok = g.EdgeIndex(vD, vC)
+			ut.True(t, ok)
+
+			_, _, ok = g.EdgeIndex(vD, vA)
+			ut.True(t, ok)
+		})
+	}
+}
+
+// TestGRemoval_random applies random batch and swap removals to every kind of graph.
+func TestGRemoval_random(t *testing.T) {
+	gens := map[string]func() *G{
+		"graph": func() *G {
+			return NewGraphWith(Multigraph())
+		},
+		"digraph": func() *G {
+			return NewDigraphWith(Multigraph())
+		},
+		"legacy": func() *G {
+			return NewGraphWith(LegacyUndirected(), Multigraph())
+		},
+		"indexed": func() *G {
+			return NewDigraphWith(InEdgeIndex(), Multigraph())
+		},
+	}
+
+	for name, gen := range gens {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(5))
+			g := gen()
+
+			item := func() Item {
+				return Text(strconv.Itoa(r.Intn(20)))
+			}
+
+			for i := 0; i < 1000; i++ {
+				switch op := r.Intn(10); {
+				case op < 3:
+					g.AddVertex(item())
+
+				case op < 8:
+					g.AddEdge(item(), item(), float64(r.Intn(10)))
+
+				case op < 9:
+					g.SwapRemoveVertex(item())
+
+				default:
+					is := []Item{}
+
+					for _, gv := range g.V {
+						if r.Intn(4) == 0 {
+							is = append(is, gv.Item)
+						}
+					}
+
+					ut.Nil(t, g.RemoveVertices(is...))
+				}
+
+				copies := 0
+
+				for v, gv := range g.V {
+					ut.Equal(t, v, gv.Index)
+
+					idx, ok := g.VertexByID(gv.ID)
+
+					ut.True(t, ok)
+					ut.Equal(t, v, idx)
+
+					for e, edge := range gv.E {
+						ut.Equal(t, v, edge.Src)
+						ut.Equal(t, e, edge.Index)
+						ut.True(t, edge.Dst < len(g.V))
+
+						src, idx, ok := g.EdgeByID(edge.ID)
+
+						ut.True(t, ok)
+						ut.Equal(t, edge.Wt, g.V[src].E[idx].Wt)
+					}
+
+					copies += len(gv.E)
+				}
+
+				switch name {
+
+				case "graph":
+					checkLinks(t, g)
+
+				case "indexed":
+					checkInEdges(t, g)
+
+				default:
+					ut.Equal(t, copies, g.EdgeCount())
+				}
+			}
+		})
+	}
+}
+
+func TestGTombstones(t *testing.T) {
+	gens := map[string]func(...GraphOpt) *G{
+		"graph":   NewGraphWith,
+		"digraph": NewDigraphWith,
+		"legacy": func(opts ...GraphOpt) *G {
+			return NewGraphWith(append(opts, LegacyUndirected())...)
+		},
+	}
+
+	for name, gen := range gens {
+		t.Run(name, func(t *testing.T) {
+			g, plain := gen(Tombstones()), gen()
+
+			for _, g := range []*G{g, plain} {
+				addVerts(t, g, vA, vB, vC, vD, vE)
+				addEdges(t, g, []edge{
+					{vA, vB, 1},
+					{vB, vC, 2},
+					{vC, vD, 3},
+					{vD, vB, 4},
+					{vE, vC, 5},
+				}...)
+			}
+
+			idC := g.V[2].ID
+
+			ut.Nil(t, g.RemoveVertex(vB))
+			ut.Nil(t, plain.RemoveVertex(vB))
+
+			// no other vertex was shifted
+			ut.True(t, g.IsRemoved(1))
+			ut.Equal(t, 5, len(g.V))
+			ut.Equal(t, 4, g.VertexCount())
+			ut.Equal(t, plain.EdgeCount(), g.EdgeCount())
+
+			for v, i := range []Item{vA, vB, vC, vD, vE} {
+				if v == 1 {
+					continue
+				}
+
+				ut.False(t, g.IsRemoved(v))
+				ut.True(t, g.V[v].Item == i)
+				ut.Equal(t, v, g.V[v].Index)
+
+				for _, e := range g.V[v].E {
+					ut.True(t, e.Dst != 1)
+				}
+			}
+
+			_, ok := g.VertexIndex(vB)
+			ut.False(t, ok)
+
+			v, ok := g.VertexByID(idC)
+
+			ut.True(t, ok)
+			ut.Equal(t, 2, v)
+
+			switch name {
+
+			case "graph":
+				checkLinks(t, g)
+
+			case "digraph":
+				checkInEdges(t, g)
+			}
+
+			// the dead slot is not reused
+			idx, err := g.AddVertex(vB)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 5, idx)
+
+			addVerts(t, plain, vB)
+
+			g.Compact()
+
+			ut.Equal(t, plain.String(), g.String())
+
+			for v := range g.V {
+				ut.False(t, g.IsRemoved(v))
+			}
+
+			v, ok = g.VertexByID(idC)
+
+			ut.True(t, ok)
+			ut.Equal(t, 1, v)
+		})
+	}
+}
+
+// TestGTombstones_random checks that removing vertices through dead slots and then compacting the graph
+// leaves it just like a graph whose vertices were removed right away, while keeping the other vertices in place.
+func TestGTombstones_random(t *testing.T) {
+	gens := map[string]func(...GraphOpt) *G{
+		"graph":   NewGraphWith,
+		"digraph": NewDigraphWith,
+		"legacy": func(opts ...GraphOpt) *G {
+			return NewGraphWith(append(opts, LegacyUndirected())...)
+		},
+	}
+
+	for name, gen := range gens {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(7))
+
+			g, plain := gen(Tombstones(), Multigraph()), gen(Multigraph())
+
+			item := func() Item {
+				return Text(strconv.Itoa(r.Intn(20)))
+			}
+
+			for i := 0; i < 1000; i++ {
+				switch op := r.Intn(20); {
+				case op < 6:
+					i := item()
+					g.AddVertex(i)
+					plain.AddVertex(i)
+
+				case op < 15:
+					src, dst, wt := item(), item(), float64(r.Intn(10))
+					g.AddEdge(src, dst, wt)
+					plain.AddEdge(src, dst, wt)
+
+				case op < 18:
+					i := item()
+					held := append([]GV(nil), g.V...)
+
+					ut.True(t, plain.RemoveVertex(i) == g.RemoveVertex(i))
+
+					// every other vertex kept its index
+					for v := range held {
+						if held[v].Item == i || held[v].dead {
+							continue
+						}
+
+						ut.True(t, g.V[v].Item == held[v].Item)
+						ut.False(t, g.IsRemoved(v))
+					}
+
+				case op < 19:
+					g.Compact()
+
+				default:
+					i := item()
+					ut.True(t, plain.SwapRemoveVertex(i) == g.SwapRemoveVertex(i))
+				}
+
+				ut.Equal(t, plain.VertexCount(), g.VertexCount())
+				ut.Equal(t, plain.EdgeCount(), g.EdgeCount())
+
+				for v, gv := range g.V {
+					if gv.dead {
+						ut.Equal(t, 0, len(gv.E))
+						continue
+					}
+
+					idx, ok := g.VertexByID(gv.ID)
+
+					ut.True(t, ok)
+					ut.Equal(t, v, idx)
+				}
+
+				switch name {
+
+				case "graph":
+					checkLinks(t, g)
+
+				case "digraph":
+					checkInEdges(t, g)
+				}
+
+				c := g.Clone()
+				c.Compact()
+
+				ut.Equal(t, plain.String(), c.String())
+			}
+		})
+	}
+}
+
+func TestGClone(t *testing.T) {
+	gens := map[string]func() *G{
+		undirectedGraphKey: NewGraph,
+		directedGraphKey:   NewDigraph,
+		"indexed":          func() *G { return NewDigraphWith(InEdgeIndex()) },
+		"legacy":           func() *G { return NewGraphWith(LegacyUndirected()) },
+	}
+
+	for gType, gen := range gens {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vB, vC, 2},
+				{vC, vD, 3},
+				{vD, vA, 4},
+			}...)
+
+			g.V[0].SetFmtAttr("color", "red")
+			g.V[0].E[0].SetFmtAttr("style", "bold")
+
+			_, cd, ok := g.EdgeIndex(vC, vD)
+			ut.True(t, ok)
+
+			vID := g.V[2].ID
+			eID := g.V[2].E[cd].ID
+
+			c := g.Clone()
+
+			ut.Equal(t, g.Directed(), c.Directed())
+			ut.Equal(t, g.VertexCount(), c.VertexCount())
+			ut.Equal(t, g.EdgeCount(), c.EdgeCount())
+			ut.Equal(t, g.String(), c.String())
+
+			ut.Equal(t, "red", c.V[0].F["color"])
+			ut.Equal(t, "bold", c.V[0].E[0].F["style"])
+
+			for v := range g.V {
+				ut.True(t, g.V[v].Item == c.V[v].Item)
+			}
+
+			// handles are valid in both graphs
+			v, ok := c.VertexByID(vID)
+			ut.True(t, ok)
+			ut.Equal(t, 2, v)
+
+			// modifying the clone leaves the original untouched
+			c.V[0].SetFmtAttr("color", "blue")
+			c.V[0].E[0].SetFmtAttr("style", "dashed")
+
+			ut.Equal(t, "red", g.V[0].F["color"])
+			ut.Equal(t, "bold", g.V[0].E[0].F["style"])
+
+			ut.Nil(t, c.RemoveVertex(vB))
+			ut.Nil(t, c.RemoveEdgeByID(eID))
+
+			_, _, ok = c.EdgeByID(eID)
+			ut.False(t, ok)
+
+			_, e, ok := g.EdgeByID(eID)
+			ut.True(t, ok)
+			ut.Equal(t, cd, e)
+
+			ut.Equal(t, 4, g.VertexCount())
+			ut.Equal(t, 3, c.VertexCount())
+
+			_, ok = g.VertexIndex(vB)
+			ut.True(t, ok)
+
+			if g.linked() {
+				checkLinks(t, g)
+				checkLinks(t, c)
+			}
+
+			if g.indexed() {
+				checkInEdges(t, g)
+				checkInEdges(t, c)
+			}
+
+			// the clone keeps allocating handles on its own
+			_, err := c.AddVertex(vE)
+			ut.Nil(t, err)
+
+			_, ok = g.VertexIndex(vE)
+			ut.False(t, ok)
+		})
+	}
+}
+
+func TestGCloneStructure(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB)
+	addEdges(t, g, edge{vA, vB, 1})
+
+	g.V[0].SetFmtAttr("color", "red")
+	g.V[0].E[0].SetFmtAttr("style", "bold")
+
+	c := g.CloneStructure()
+
+	ut.Equal(t, g.String(), c.String())
+
+	ut.Equal(t, 0, len(c.V[0].F))
+	ut.Equal(t, 0, len(c.V[0].E[0].F))
+
+	c.V[0].SetFmtAttr("color", "blue")
+
+	ut.Equal(t, "red", g.V[0].F["color"])
+
+	checkLinks(t, c)
+}
+
+func TestGAccept(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, edge{vA, vB, 1}, edge{vB, vC, 2})
+
+	gVis := &counterGraphVisitor{}
+
+	g.Accept(gVis)
+
+	ut.Equal(t, 2, gVis.gCalls)
+	ut.Equal(t, 3, gVis.vCalls)
+	ut.Equal(t, 4, gVis.eCalls)
+
+	vis := &counterVisitor{}
+
+	g.Walk(vis)
+
+	ut.Equal(t, 2, vis.gCalls)
+	ut.Equal(t, 3, vis.vCalls)
+	ut.Equal(t, 4, vis.eCalls)
+}
@@ -16,13 +16,10 @@ func ExampleG_undirected() {
 	g.AddVertex(&rt)
 
 	g.AddEdge(&wt, &dt, 0)
-	g.AddEdge(&dt, &wt, 0)
 
 	g.AddEdge(&wt, &mt, 0)
-	g.AddEdge(&mt, &wt, 0)
 
 	g.AddEdge(&dt, &mt, 0)
-	g.AddEdge(&mt, &dt, 0)
 
 	fmt.Println(g.VertexCount())
 
@@ -30,5 +27,5 @@ func ExampleG_undirected() {
 
 	// Output:
 	// 4
-	// 6
+	// 3
 }
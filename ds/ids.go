@@ -0,0 +1,159 @@
+package ds
+
+/*
+A VertexID is a stable handle to a vertex of a G. Unlike the index of the vertex, which changes
+whenever an earlier vertex is removed, the handle stays valid for as long as the vertex exists.
+Once the vertex is removed, the handle becomes stale: its slot can be reused by a new vertex,
+but under a different generation, so a stale handle is never resolved to another vertex.
+
+The zero value is never a valid handle.
+*/
+type VertexID struct {
+	slot int
+	gen  uint32
+}
+
+/*
+An EdgeID is a stable handle to an edge of a G, which stays valid for as long as the edge
+exists, regardless of any other vertices or edges being removed. Both copies of an undirected
+edge share the same handle, which always resolves to the copy that was added by AddEdge.
+
+The zero value is never a valid handle.
+*/
+type EdgeID struct {
+	slot int
+	gen  uint32
+}
+
+// idSlot tracks the current location of whatever is identified by a handle.
+type idSlot struct {
+	gen  uint32
+	live bool
+
+	// v is the index of a vertex, or for edges, the slot of the handle of their source vertex.
+	v int
+
+	// e is the index of an edge in the adjacency list of its source vertex, unused for vertices.
+	e int
+}
+
+// idTable allocates and releases the slots of handles, reusing released slots.
+type idTable struct {
+	slots []idSlot
+	free  []int
+}
+
+// alloc allocates a slot for a new handle, tracking the given location.
+func (t *idTable) alloc(v, e int) (int, uint32) {
+	var slot int
+
+	if n := len(t.free); n != 0 {
+		slot = t.free[n-1]
+		t.free = t.free[:n-1]
+	} else {
+		t.slots = append(t.slots, idSlot{})
+		slot = len(t.slots) - 1
+	}
+
+	s := &t.slots[slot]
+
+	// a new generation makes any stale handles to the slot invalid
+	s.gen++
+	s.live = true
+	s.v = v
+	s.e = e
+
+	return slot, s.gen
+}
+
+// release releases the slot of a handle, so it can be reused.
+func (t *idTable) release(slot int) {
+	t.slots[slot].live = false
+	t.free = append(t.free, slot)
+}
+
+// get retrieves the slot of a handle, but only if the handle is still valid.
+func (t *idTable) get(slot int, gen uint32) (*idSlot, bool) {
+	if slot < 0 || slot >= len(t.slots) {
+		return nil, false
+	}
+
+	s := &t.slots[slot]
+
+	if !s.live || s.gen != gen {
+		return nil, false
+	}
+
+	return s, true
+}
+
+// clone creates a copy of the table, which tracks the same handles as the original one.
+func (t *idTable) clone() idTable {
+	return idTable{
+		slots: append([]idSlot(nil), t.slots...),
+		free:  append([]int(nil), t.free...),
+	}
+}
+
+// trackEdge records the new index of an edge that was shifted in the adjacency list of v.
+func (g *G) trackEdge(v int, edge *GE) {
+	s := &g.eIDs.slots[edge.ID.slot]
+
+	// only the copy added by AddEdge is tracked
+	if s.v == g.V[v].ID.slot {
+		s.e = edge.Index
+	}
+}
+
+// VertexByID retrieves the current index of the vertex identified by the given handle, in O(1) time.
+func (g *G) VertexByID(id VertexID) (int, bool) {
+	s, ok := g.vIDs.get(id.slot, id.gen)
+
+	if !ok {
+		return 0, false
+	}
+
+	return s.v, true
+}
+
+/*
+EdgeByID retrieves the current index(es) of the edge identified by the given handle, in O(1) time:
+the index of its source vertex, and its index in the adjacency list of that vertex.
+*/
+func (g *G) EdgeByID(id EdgeID) (int, int, bool) {
+	s, ok := g.eIDs.get(id.slot, id.gen)
+
+	if !ok {
+		return 0, 0, false
+	}
+
+	return g.vIDs.slots[s.v].v, s.e, true
+}
+
+/*
+RemoveVertexByID removes the vertex identified by the given handle, along with any edges incident on it.
+The vertex is found in O(1) time, and then removed just like RemoveVertex would, which only takes time
+proportional to the degrees of the vertex and of its neighbors in graphs created using Tombstones.
+*/
+func (g *G) RemoveVertexByID(id VertexID) error {
+	v, ok := g.VertexByID(id)
+
+	if !ok {
+		return ErrNoVtx
+	}
+
+	return g.RemoveVertex(g.V[v].Item)
+}
+
+// RemoveEdgeByID removes the edge identified by the given handle.
+func (g *G) RemoveEdgeByID(id EdgeID) error {
+	v, e, ok := g.EdgeByID(id)
+
+	if !ok {
+		return ErrNoEdge
+	}
+
+	g.cutEdge(v, e)
+
+	return nil
+}
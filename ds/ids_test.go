@@ -0,0 +1,186 @@
+package ds
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestGVertexByID(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+
+	idA, idB, idC := g.V[0].ID, g.V[1].ID, g.V[2].ID
+
+	_, ok := g.VertexByID(VertexID{})
+	ut.False(t, ok)
+
+	ut.Nil(t, g.RemoveVertex(vA))
+
+	_, ok = g.VertexByID(idA)
+	ut.False(t, ok)
+
+	v, ok := g.VertexByID(idB)
+
+	ut.True(t, ok)
+	ut.Equal(t, 0, v)
+
+	v, ok = g.VertexByID(idC)
+
+	ut.True(t, ok)
+	ut.Equal(t, 1, v)
+
+	// the slot is reused, but the stale handle stays invalid
+	addVerts(t, g, vD)
+
+	_, ok = g.VertexByID(idA)
+	ut.False(t, ok)
+
+	v, ok = g.VertexByID(g.V[2].ID)
+
+	ut.True(t, ok)
+	ut.Equal(t, 2, v)
+
+	ut.Nil(t, g.RemoveVertexByID(idC))
+	ut.NotNil(t, g.RemoveVertexByID(idC))
+
+	ut.Equal(t, 2, g.VertexCount())
+}
+
+func TestGEdgeByID(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vB, vA, 1},
+				{vB, vC, 2},
+				{vC, vD, 3},
+				{vB, vD, 4},
+			}...)
+
+			id := g.V[1].E[2].ID
+
+			ut.Nil(t, g.RemoveVertex(vA))
+			ut.Nil(t, g.RemoveEdge(vB, vC))
+
+			v, e, ok := g.EdgeByID(id)
+
+			ut.True(t, ok)
+			ut.Equal(t, 4.0, g.V[v].E[e].Wt)
+			ut.True(t, g.V[v].Item == vB)
+
+			ut.Nil(t, g.RemoveEdgeByID(id))
+			ut.NotNil(t, g.RemoveEdgeByID(id))
+
+			_, _, ok = g.EdgeIndex(vB, vD)
+			ut.False(t, ok)
+
+			ut.Equal(t, 1, g.EdgeCount())
+		})
+	}
+}
+
+// TestGIDs_random checks that handles survive a random sequence of operations on every kind of graph.
+func TestGIDs_random(t *testing.T) {
+	gens := map[string]func() *G{
+		"graph":   NewGraph,
+		"digraph": NewDigraph,
+		"multigraph": func() *G {
+			return NewGraphWith(Multigraph())
+		},
+		"legacy": func() *G {
+			return NewGraphWith(LegacyUndirected(), Multigraph())
+		},
+		"indexed": func() *G {
+			return NewDigraphWith(InEdgeIndex(), Multigraph())
+		},
+		"tombstones": func() *G {
+			return NewGraphWith(Tombstones(), Multigraph())
+		},
+		"tombstones-digraph": func() *G {
+			return NewDigraphWith(Tombstones(), Multigraph())
+		},
+	}
+
+	for name, gen := range gens {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(3))
+			g := gen()
+
+			// the weight of every edge is unique, identifying it
+			wt := 0.0
+
+			vIDs := map[VertexID]Item{}
+			eIDs := map[EdgeID]float64{}
+
+			stale := []VertexID{}
+
+			item := func() Item {
+				return Text(strconv.Itoa(r.Intn(15)))
+			}
+
+			for i := 0; i < 1000; i++ {
+				switch op := r.Intn(10); {
+				case op < 2:
+					if v, err := g.AddVertex(item()); err == nil {
+						vIDs[g.V[v].ID] = g.V[v].Item
+					}
+
+				case op < 7:
+					wt++
+
+					if v, e, err := g.AddEdge(item(), item(), wt); err == nil {
+						eIDs[g.V[v].E[e].ID] = wt
+					}
+
+				case op < 9:
+					if v, ok := g.VertexIndex(item()); ok && len(g.V[v].E) != 0 {
+						g.RemoveEdgeAt(v, r.Intn(len(g.V[v].E)))
+					}
+
+				default:
+					if v, ok := g.VertexIndex(item()); ok {
+						id := g.V[v].ID
+
+						ut.Nil(t, g.RemoveVertexByID(id))
+
+						delete(vIDs, id)
+						stale = append(stale, id)
+					}
+				}
+
+				for id, i := range vIDs {
+					v, ok := g.VertexByID(id)
+
+					ut.True(t, ok)
+					ut.True(t, g.V[v].Item == i)
+				}
+
+				for _, id := range stale {
+					_, ok := g.VertexByID(id)
+					ut.False(t, ok)
+				}
+
+				live := 0
+
+				for id, w := range eIDs {
+					v, e, ok := g.EdgeByID(id)
+
+					if !ok {
+						continue
+					}
+
+					ut.Equal(t, w, g.V[v].E[e].Wt)
+					live++
+				}
+
+				ut.Equal(t, g.EdgeCount(), live)
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+package ds
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// mCell holds the data of a potential edge in an adjacency matrix.
+type mCell struct {
+	Formattable
+
+	wt float64
+}
+
+/*
+M implements a directed or undirected graph G = (V, E), using an adjacency matrix
+to achieve constant time complexity when checking whether an edge (u, v) exists,
+at the cost of a space complexity of Θ(V²), regardless of the number of edges.
+
+The presence of edges is tracked by a dense bitset, while their weights and
+formatting attributes are stored in a flat matrix. Removing a vertex needs
+to shift every row and column that comes after it, which takes Θ(V²) time.
+
+M offers the same surface as G, but since there are no adjacency lists,
+the Index of every edge is the index of its destination vertex (the column
+of the edge in the matrix), and edges leaving a vertex are always visited
+in the order of their destination vertices.
+
+In an undirected graph, the matrix is kept symmetric: adding or removing the edge (u, v)
+also adds or removes its reverse copy (v, u), and the edge is only counted once.
+*/
+type M struct {
+	/*
+		V is the list of vertices in the graph, ordered by vertex insertion time,
+		an ordering that is respected during any internal traversals. Since edges
+		are stored in the matrix, the adjacency list (E) of every vertex is empty.
+	*/
+	V []GV
+
+	sat    map[Item]int
+	dir    bool
+	eCount int
+
+	// stride is the number of rows and columns allocated for the matrix.
+	stride int
+	adj    []uint64
+	cells  []mCell
+}
+
+func newM(dir bool) *M {
+	m := &M{}
+
+	m.V = make([]GV, 0)
+
+	m.sat = map[Item]int{}
+	m.dir = dir
+
+	return m
+}
+
+// NewMatrixGraph creates a new undirected graph, backed by an adjacency matrix.
+func NewMatrixGraph() *M {
+	return newM(false)
+}
+
+// NewMatrixDigraph creates a new directed graph, backed by an adjacency matrix.
+func NewMatrixDigraph() *M {
+	return newM(true)
+}
+
+func (m *M) String() string {
+	b := strings.Builder{}
+
+	if m.Undirected() {
+		b.WriteString("Graph")
+	} else {
+		b.WriteString("Digraph")
+	}
+
+	b.WriteString(fmt.Sprintf(" |V| = %d", m.VertexCount()))
+	b.WriteString(fmt.Sprintf(" |E| = %d\n", m.EdgeCount()))
+
+	for v := range m.V {
+		es := []string{}
+
+		m.ForEachEdge(v, func(e GE) {
+			es = append(es, e.String())
+		})
+
+		b.WriteString(fmt.Sprintf("Vertex '%s' @%d adj [", m.V[v].Label(), v))
+		b.WriteString(strings.Join(es, ", "))
+		b.WriteString("]\n")
+	}
+
+	return b.String()
+}
+
+// pos calculates the position of the cell (u, v) in the matrix.
+func (m *M) pos(u, v int) int {
+	return u*m.stride + v
+}
+
+// has checks whether the edge (u, v) exists.
+func (m *M) has(u, v int) bool {
+	p := m.pos(u, v)
+	return m.adj[p/64]&(1<<(p%64)) != 0
+}
+
+// mark sets or clears the presence bit of the edge (u, v).
+func (m *M) mark(u, v int, on bool) {
+	p := m.pos(u, v)
+
+	if on {
+		m.adj[p/64] |= 1 << (p % 64)
+	} else {
+		m.adj[p/64] &^= 1 << (p % 64)
+	}
+}
+
+// grow doubles the number of rows and columns allocated for the matrix, keeping any existing edges.
+func (m *M) grow() {
+	stride := 2 * m.stride
+
+	if stride == 0 {
+		stride = 1
+	}
+
+	res := &M{stride: stride}
+
+	res.adj = make([]uint64, (stride*stride+63)/64)
+	res.cells = make([]mCell, stride*stride)
+
+	for u := range m.V {
+		for v := range m.V {
+			if !m.has(u, v) {
+				continue
+			}
+
+			res.mark(u, v, true)
+			res.cells[res.pos(u, v)] = m.cells[m.pos(u, v)]
+		}
+	}
+
+	m.stride = stride
+	m.adj = res.adj
+	m.cells = res.cells
+}
+
+// edge builds the GE value for an existing edge (u, v).
+func (m *M) edge(u, v int) GE {
+	c := m.cells[m.pos(u, v)]
+
+	e := GE{
+		Formattable: c.Formattable,
+		Src:         u,
+		Dst:         v,
+		Wt:          c.wt,
+		Index:       v,
+	}
+
+	if m.Undirected() {
+		e.Rev = u
+	}
+
+	return e
+}
+
+// ForEachEdge calls fn for every edge leaving the vertex at the given index, in the order of their destination vertices.
+func (m *M) ForEachEdge(v int, fn func(GE)) {
+	row := m.pos(v, 0)
+
+	for u := 0; u < len(m.V); {
+		p := row + u
+		word := m.adj[p/64] >> (p % 64)
+
+		if word == 0 {
+			// skipping to the start of the next word
+			u += 64 - p%64
+			continue
+		}
+
+		u += bits.TrailingZeros64(word)
+
+		if u >= len(m.V) {
+			break
+		}
+
+		fn(m.edge(v, u))
+
+		u++
+	}
+}
+
+// Directed checks whether or not the graph is directed.
+func (m *M) Directed() bool {
+	return m.dir
+}
+
+// Undirected checks whether or not the graph is undirected.
+func (m *M) Undirected() bool {
+	return !m.dir
+}
+
+// VertexCount calculates the size of the set of vertices, |V|, in O(1) time.
+func (m *M) VertexCount() int {
+	return len(m.V)
+}
+
+// EdgeCount calculates the size of the set of edges, |E|, in O(1) time.
+func (m *M) EdgeCount() int {
+	return m.eCount
+}
+
+// VertexIndex retrieves the index of the vertex associated with the given Item.
+func (m *M) VertexIndex(i Item) (int, bool) {
+	idx, ok := m.sat[i]
+	return idx, ok
+}
+
+// Item retrieves the Item associated with the vertex at the given index.
+func (m *M) Item(v int) Item {
+	return m.V[v].Item
+}
+
+/*
+EdgeIndex retrieves the index(es) of the edge associated with the given Items, in O(1) time.
+The index of an edge is the index of its destination vertex.
+*/
+func (m *M) EdgeIndex(src Item, dst Item) (int, int, bool) {
+	iSrc, ok := m.sat[src]
+
+	if !ok {
+		return 0, 0, false
+	}
+
+	iDst, ok := m.sat[dst]
+
+	if !ok {
+		return 0, 0, false
+	}
+
+	if !m.has(iSrc, iDst) {
+		return 0, 0, false
+	}
+
+	return iSrc, iDst, true
+}
+
+// Edge retrieves the edge between the vertices at the given indexes, in O(1) time.
+func (m *M) Edge(src, dst int) (GE, bool) {
+	if !m.has(src, dst) {
+		return GE{}, false
+	}
+
+	return m.edge(src, dst), true
+}
+
+/*
+EdgeFmt retrieves the formatting attributes of the edge between the vertices
+at the given indexes, so they can be changed, or nil if there is no such edge.
+*/
+func (m *M) EdgeFmt(src, dst int) *Formattable {
+	if !m.has(src, dst) {
+		return nil
+	}
+
+	return &m.cells[m.pos(src, dst)].Formattable
+}
+
+// VertexFmt retrieves the formatting attributes of the vertex at the given index, so they can be changed.
+func (m *M) VertexFmt(v int) *Formattable {
+	return &m.V[v].Formattable
+}
+
+/*
+ReverseEdge retrieves the index(es) of the reverse copy of the edge (v, e) in an undirected graph,
+in O(1) time. Since the index of an edge is the index of its destination vertex, the reverse copy is (e, v).
+*/
+func (m *M) ReverseEdge(v, e int) (int, int, bool) {
+	if m.Directed() || !m.has(v, e) {
+		return 0, 0, false
+	}
+
+	return e, v, true
+}
+
+// AddVertex adds a new vertex to the graph, associated with the given Item.
+func (m *M) AddVertex(i Item) (int, error) {
+	if _, ok := m.sat[i]; ok {
+		return 0, ErrExists
+	}
+
+	if len(m.V) == m.stride {
+		m.grow()
+	}
+
+	m.V = append(m.V, GV{Item: i})
+
+	idx := len(m.V) - 1
+
+	m.V[idx].Index = idx
+	m.sat[i] = idx
+
+	return idx, nil
+}
+
+// AddEdge adds a new weighted edge between the given Items, but only if their vertices have already been added.
+func (m *M) AddEdge(src Item, dst Item, wt float64) (int, int, error) {
+	if m.Undirected() && src == dst {
+		return 0, 0, ErrInvLoop
+	}
+
+	iSrc, ok := m.sat[src]
+
+	if !ok {
+		return 0, 0, ErrNoVtx
+	}
+
+	iDst, ok := m.sat[dst]
+
+	if !ok {
+		return 0, 0, ErrNoVtx
+	}
+
+	if m.has(iSrc, iDst) {
+		return 0, 0, ErrExists
+	}
+
+	m.mark(iSrc, iDst, true)
+	m.cells[m.pos(iSrc, iDst)] = mCell{wt: wt}
+
+	if m.Undirected() {
+		m.mark(iDst, iSrc, true)
+		m.cells[m.pos(iDst, iSrc)] = mCell{wt: wt}
+	}
+
+	m.eCount++
+
+	return iSrc, iDst, nil
+}
+
+// RemoveVertex removes the vertex associated with the given Item, along with any edges incident on it.
+func (m *M) RemoveVertex(i Item) error {
+	iDel, ok := m.sat[i]
+
+	if !ok {
+		return ErrNoVtx
+	}
+
+	n := len(m.V)
+
+	for v := 0; v < n; v++ {
+		if m.has(iDel, v) {
+			m.eCount--
+		}
+
+		// undirected edges are counted once
+		if v != iDel && m.has(v, iDel) && m.Directed() {
+			m.eCount--
+		}
+	}
+
+	// the new position of every cell is never after its old
+	// position, so cells can be shifted in place, as long
+	// as they are processed in increasing order
+	for u := 0; u < n-1; u++ {
+		oldU := u
+
+		if u >= iDel {
+			oldU++
+		}
+
+		for v := 0; v < n-1; v++ {
+			oldV := v
+
+			if v >= iDel {
+				oldV++
+			}
+
+			on := m.has(oldU, oldV)
+			c := m.cells[m.pos(oldU, oldV)]
+
+			m.mark(u, v, on)
+			m.cells[m.pos(u, v)] = c
+		}
+	}
+
+	// clearing the last row and column,
+	// which are no longer being used
+	for v := 0; v < n; v++ {
+		m.mark(n-1, v, false)
+		m.mark(v, n-1, false)
+
+		m.cells[m.pos(n-1, v)] = mCell{}
+		m.cells[m.pos(v, n-1)] = mCell{}
+	}
+
+	Cut(&m.V, iDel)
+	delete(m.sat, i)
+
+	for v := iDel; v < len(m.V); v++ {
+		m.sat[m.V[v].Item] = v
+		m.V[v].Index = v
+	}
+
+	return nil
+}
+
+// RemoveEdge removes the edge associated with the given Items, in O(1) time.
+func (m *M) RemoveEdge(src Item, dst Item) error {
+	iSrc, iDst, ok := m.EdgeIndex(src, dst)
+
+	if !ok {
+		return ErrNoEdge
+	}
+
+	m.mark(iSrc, iDst, false)
+	m.cells[m.pos(iSrc, iDst)] = mCell{}
+
+	if m.Undirected() {
+		m.mark(iDst, iSrc, false)
+		m.cells[m.pos(iDst, iSrc)] = mCell{}
+	}
+
+	m.eCount--
+
+	return nil
+}
+
+// Walk guides the execution of a Visitor over every vertex and edge of the graph, in order.
+func (m *M) Walk(vis Visitor) {
+	walk(m, vis, func(v int) GV {
+		return m.V[v]
+	})
+}
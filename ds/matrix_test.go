@@ -0,0 +1,278 @@
+package ds
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+var matrixGen = map[string]func() *M{
+	undirectedGraphKey: NewMatrixGraph,
+	directedGraphKey:   NewMatrixDigraph,
+}
+
+// sameGraph checks that an adjacency matrix holds the same vertices and edges as an adjacency list.
+func sameGraph(t *testing.T, g *G, m *M) {
+	ut.Equal(t, g.VertexCount(), m.VertexCount())
+	ut.Equal(t, g.EdgeCount(), m.EdgeCount())
+
+	for v := range g.V {
+		ut.True(t, g.V[v].Item == m.V[v].Item)
+		ut.Equal(t, v, m.V[v].Index)
+
+		idx, ok := m.VertexIndex(g.V[v].Item)
+
+		ut.True(t, ok)
+		ut.Equal(t, v, idx)
+
+		count := 0
+
+		for u := range g.V {
+			_, _, okG := g.EdgeIndex(g.V[v].Item, g.V[u].Item)
+			e, okM := m.Edge(v, u)
+
+			ut.Equal(t, okG, okM)
+
+			if okM {
+				count++
+
+				ut.Equal(t, v, e.Src)
+				ut.Equal(t, u, e.Dst)
+				ut.Equal(t, u, e.Index)
+			}
+		}
+
+		ut.Equal(t, len(g.V[v].E), count)
+
+		for _, e := range g.V[v].E {
+			me, ok := m.Edge(e.Src, e.Dst)
+
+			ut.True(t, ok)
+			ut.Equal(t, e.Wt, me.Wt)
+		}
+	}
+}
+
+func TestMNewMatrixGraph(t *testing.T) {
+	m := NewMatrixGraph()
+	ut.True(t, m.Undirected())
+	ut.False(t, m.Directed())
+}
+
+func TestMNewMatrixDigraph(t *testing.T) {
+	m := NewMatrixDigraph()
+	ut.True(t, m.Directed())
+	ut.False(t, m.Undirected())
+}
+
+func TestMAddVertex(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	idx, err := m.AddVertex(vA)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, idx)
+
+	idx, err = m.AddVertex(vB)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, idx)
+
+	_, err = m.AddVertex(vA)
+
+	ut.True(t, errors.Is(err, ErrExists))
+	ut.Equal(t, 2, m.VertexCount())
+}
+
+func TestMAddEdge(t *testing.T) {
+	for name, gen := range matrixGen {
+		t.Run(name, func(t *testing.T) {
+			m := gen()
+
+			m.AddVertex(vA)
+			m.AddVertex(vB)
+
+			src, dst, err := m.AddEdge(vA, vB, 2)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 0, src)
+			ut.Equal(t, 1, dst)
+			ut.Equal(t, 1, m.EdgeCount())
+
+			_, _, err = m.AddEdge(vA, vB, 3)
+			ut.True(t, errors.Is(err, ErrExists))
+
+			_, _, err = m.AddEdge(vA, vC, 3)
+			ut.True(t, errors.Is(err, ErrNoVtx))
+
+			_, _, err = m.AddEdge(vC, vA, 3)
+			ut.True(t, errors.Is(err, ErrNoVtx))
+
+			_, _, err = m.AddEdge(vA, vA, 3)
+
+			if m.Directed() {
+				ut.Nil(t, err)
+			} else {
+				ut.True(t, errors.Is(err, ErrInvLoop))
+			}
+		})
+	}
+}
+
+func TestMUndirected_symmetric(t *testing.T) {
+	m := NewMatrixGraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+	m.AddVertex(vC)
+
+	m.AddEdge(vA, vB, 2)
+	m.AddEdge(vC, vA, 3)
+
+	ut.Equal(t, 2, m.EdgeCount())
+
+	e, ok := m.Edge(1, 0)
+
+	ut.True(t, ok)
+	ut.Equal(t, 2.0, e.Wt)
+	ut.Equal(t, 1, e.Rev)
+
+	_, _, err := m.AddEdge(vB, vA, 1)
+	ut.True(t, errors.Is(err, ErrExists))
+
+	ut.Nil(t, m.RemoveEdge(vB, vA))
+	ut.Equal(t, 1, m.EdgeCount())
+
+	_, ok = m.Edge(0, 1)
+	ut.False(t, ok)
+
+	ut.Nil(t, m.RemoveVertex(vA))
+	ut.Equal(t, 0, m.EdgeCount())
+}
+
+func TestMEdgeIndex(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+	m.AddVertex(vC)
+
+	m.AddEdge(vC, vA, 1)
+
+	src, dst, ok := m.EdgeIndex(vC, vA)
+
+	ut.True(t, ok)
+	ut.Equal(t, 2, src)
+	ut.Equal(t, 0, dst)
+
+	_, _, ok = m.EdgeIndex(vA, vC)
+	ut.False(t, ok)
+
+	_, _, ok = m.EdgeIndex(vD, vA)
+	ut.False(t, ok)
+
+	_, _, ok = m.EdgeIndex(vA, vD)
+	ut.False(t, ok)
+}
+
+func TestMEdgeFmt(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+
+	m.AddEdge(vA, vB, 1)
+
+	ut.Nil(t, m.EdgeFmt(1, 0))
+
+	m.EdgeFmt(0, 1).SetFmtAttr("color", "red")
+
+	e, ok := m.Edge(0, 1)
+
+	ut.True(t, ok)
+	ut.Equal(t, "red", e.F["color"])
+}
+
+func TestMRemoveVertex_no_vertex(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	ut.True(t, errors.Is(m.RemoveVertex(vA), ErrNoVtx))
+}
+
+func TestMRemoveEdge_no_edge(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+
+	ut.True(t, errors.Is(m.RemoveEdge(vA, vB), ErrNoEdge))
+}
+
+func TestMWalk(t *testing.T) {
+	m := NewMatrixDigraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+	m.AddVertex(vC)
+
+	m.AddEdge(vA, vB, 1)
+	m.AddEdge(vB, vB, 1)
+	m.AddEdge(vC, vA, 1)
+
+	vis := &counterVisitor{}
+
+	m.Walk(vis)
+
+	ut.Equal(t, 2, vis.gCalls)
+	ut.Equal(t, 3, vis.vCalls)
+	ut.Equal(t, 3, vis.eCalls)
+}
+
+// TestM_random applies the same random sequence of operations to both representations.
+func TestM_random(t *testing.T) {
+	for name := range matrixGen {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(11))
+
+			g := graphGen[name]()
+			m := matrixGen[name]()
+
+			item := func() Item {
+				return Text(strconv.Itoa(r.Intn(100)))
+			}
+
+			for i := 0; i < 1000; i++ {
+				switch op := r.Intn(10); {
+				case op < 3:
+					i := item()
+
+					_, errG := g.AddVertex(i)
+					_, errM := m.AddVertex(i)
+
+					ut.True(t, errG == errM)
+
+				case op < 7:
+					src, dst, wt := item(), item(), float64(r.Intn(10))
+
+					_, _, errG := g.AddEdge(src, dst, wt)
+					_, _, errM := m.AddEdge(src, dst, wt)
+
+					ut.True(t, errG == errM)
+
+				case op < 9:
+					src, dst := item(), item()
+					ut.True(t, g.RemoveEdge(src, dst) == m.RemoveEdge(src, dst))
+
+				default:
+					i := item()
+					ut.True(t, g.RemoveVertex(i) == m.RemoveVertex(i))
+				}
+
+				sameGraph(t, g, m)
+			}
+		})
+	}
+}
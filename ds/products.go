@@ -0,0 +1,240 @@
+package ds
+
+// hasLoop checks whether or not a graph has any loops.
+func hasLoop(g Graph) bool {
+	found := false
+
+	for v := 0; v < g.VertexCount() && !found; v++ {
+		g.ForEachEdge(v, func(e GE) {
+			if e.Dst == v {
+				found = true
+			}
+		})
+	}
+
+	return found
+}
+
+/*
+product creates a product of two graphs, which must be either both directed or both undirected,
+holding a vertex for every pair of vertices (u, v), where u is in the first graph and v is in the
+second one, and the edges of its Cartesian part (cart), its tensor part (tensor), or both.
+*/
+func product(g1, g2 Graph, cart, tensor bool) (*G, error) {
+	if g1.Directed() != g2.Directed() {
+		return nil, ErrMixedGraphs
+	}
+
+	opts := []GraphOpt{}
+
+	loops1, loops2 := hasLoop(g1), hasLoop(g2)
+
+	// with a loop in one of the graphs, a tensor edge only changes one
+	// of the vertices of a pair, just like a Cartesian edge, and with
+	// loops in both graphs, Cartesian edges become loops themselves,
+	// so some edges can only be kept as parallel edges
+	collide := cart && ((tensor && (loops1 || loops2)) || (loops1 && loops2))
+
+	if collide || isMulti(g1, g2) {
+		opts = append(opts, Multigraph())
+	}
+
+	// every copy of an undirected edge is added on its own,
+	// and linked later, so adjacency lists follow the factors
+	if g1.Undirected() {
+		opts = append(opts, LegacyUndirected())
+	}
+
+	res := newG(g1.Directed(), opts)
+
+	n1, n2 := g1.VertexCount(), g2.VertexCount()
+
+	for u := 0; u < n1; u++ {
+		for v := 0; v < n2; v++ {
+			if _, err := res.AddVertex(&Pair{g1.Item(u), g2.Item(v)}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var err error
+
+	// add keeps the first error found, since
+	// edges are added from inside of callbacks
+	add := func(src, dst int, wt float64) {
+		if err == nil {
+			_, _, err = res.AddEdge(res.V[src].Item, res.V[dst].Item, wt)
+		}
+	}
+
+	for u := 0; u < n1; u++ {
+		for v := 0; v < n2; v++ {
+			if cart {
+				g1.ForEachEdge(u, func(e1 GE) {
+					add(u*n2+v, e1.Dst*n2+v, e1.Wt)
+				})
+
+				g2.ForEachEdge(v, func(e2 GE) {
+					add(u*n2+v, u*n2+e2.Dst, e2.Wt)
+				})
+			}
+
+			if tensor {
+				g1.ForEachEdge(u, func(e1 GE) {
+					g2.ForEachEdge(v, func(e2 GE) {
+						add(u*n2+v, e1.Dst*n2+e2.Dst, e1.Wt+e2.Wt)
+					})
+				})
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the factors might be created using LegacyUndirected,
+	// with copies of the same edge having different weights
+	if err := res.symmetrize(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+/*
+CartesianProduct creates the Cartesian product of two graphs, which must be either both directed or
+both undirected. The product has a vertex for every pair (u, v), where u is a vertex of the first graph
+and v is a vertex of the second one, holding a Pair with their Items. The vertex (u, v) is at index
+u * |V2| + v, where |V2| is the number of vertices in the second graph.
+
+There is an edge from (u, v) to (u', v) for every edge (u, u') of the first graph, and an edge from
+(u, v) to (u, v') for every edge (v, v') of the second graph, each one with the weight of the edge
+that originated it. The product is a multigraph if any of the graphs is a multigraph, or if both
+graphs have loops, since a loop of each graph adds a loop to (u, v), and both must be kept.
+
+Complexity:
+	- Time:  Θ(V1 * V2 + V1 * E2 + V2 * E1)
+	- Space: Θ(V1 * V2 + V1 * E2 + V2 * E1)
+*/
+func CartesianProduct(g1, g2 Graph) (*G, error) {
+	return product(g1, g2, true, false)
+}
+
+/*
+TensorProduct creates the tensor (categorical) product of two graphs, which must be either both directed
+or both undirected. Its vertices are created like in CartesianProduct, and there is an edge from (u, v)
+to (u', v') for every pair of edges (u, u') of the first graph and (v, v') of the second graph, with
+the sum of their weights as its weight. The product is a multigraph if any of the graphs is a multigraph.
+
+Complexity:
+	- Time:  Θ(V1 * V2 + E1 * E2)
+	- Space: Θ(V1 * V2 + E1 * E2)
+*/
+func TensorProduct(g1, g2 Graph) (*G, error) {
+	return product(g1, g2, false, true)
+}
+
+/*
+StrongProduct creates the strong product of two graphs, which must be either both directed or both
+undirected: the graph whose vertices are created like in CartesianProduct, holding the edges of both
+their Cartesian product and their tensor product (see TensorProduct).
+
+If any of the graphs has loops, a tensor edge can connect the same vertices as a Cartesian edge: with
+a loop (v, v) in the second graph, every edge (u, u') of the first one originates both a Cartesian edge
+and a tensor edge from (u, v) to (u', v), with different weights. In that case, the product is a multigraph,
+keeping both edges, just like when any of the graphs is a multigraph.
+
+Complexity:
+	- Time:  Θ(V1 * V2 + V1 * E2 + V2 * E1 + E1 * E2)
+	- Space: Θ(V1 * V2 + V1 * E2 + V2 * E1 + E1 * E2)
+*/
+func StrongProduct(g1, g2 Graph) (*G, error) {
+	return product(g1, g2, true, true)
+}
+
+/*
+LineGraph creates the line graph L(G) of a graph G, which has a vertex for every edge of G, holding a Pair
+with the Items of its vertices. Vertices of L(G) are ordered like the edges of G, which are also returned,
+with every undirected edge listed once, by the first copy found, going through the vertices in order.
+
+In a directed graph, there is an edge from (u, v) to (v, w) in L(G) for every pair of edges (u, v) and (v, w)
+in G, while in an undirected graph there is an edge between two vertices of L(G) if their edges share
+a vertex in G. Edges of L(G) have no weight, and L(G) is never a multigraph. Any error found while building
+L(G) is returned, in which case no graph is returned.
+
+Complexity:
+	- Time:  Θ(V + E + Σ deg(v)²)
+	- Space: Θ(V + E + Σ deg(v)²)
+*/
+func LineGraph(g Graph) (*G, []GE, error) {
+	es := []GE{}
+
+	// the edges leaving v are es[start[v]:start[v+1]]
+	start := make([]int, g.VertexCount()+1)
+
+	forEachOnce(g, func(e GE) {
+		es = append(es, e)
+		start[e.Src+1]++
+	})
+
+	for v := 0; v < g.VertexCount(); v++ {
+		start[v+1] += start[v]
+	}
+
+	res := newG(g.Directed(), nil)
+
+	for _, e := range es {
+		if _, err := res.AddVertex(&Pair{g.Item(e.Src), g.Item(e.Dst)}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if g.Directed() {
+		for i, e := range es {
+			for j := start[e.Dst]; j < start[e.Dst+1]; j++ {
+				if _, _, err := res.AddEdge(res.V[i].Item, res.V[j].Item, 0); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+
+		return res, es, nil
+	}
+
+	// incident lists the edges incident on every vertex
+	incident := make([][]int, g.VertexCount())
+
+	for i, e := range es {
+		incident[e.Src] = append(incident[e.Src], i)
+		incident[e.Dst] = append(incident[e.Dst], i)
+	}
+
+	other := func(i, v int) int {
+		if es[i].Src == v {
+			return es[i].Dst
+		}
+
+		return es[i].Src
+	}
+
+	for v := range incident {
+		for a := 0; a < len(incident[v]); a++ {
+			for b := a + 1; b < len(incident[v]); b++ {
+				i, j := incident[v][a], incident[v][b]
+
+				// parallel edges share both of their vertices,
+				// so they are only connected by the lowest one
+				if w := other(i, v); w == other(j, v) && w < v {
+					continue
+				}
+
+				if _, _, err := res.AddEdge(res.V[i].Item, res.V[j].Item, 0); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+
+	return res, es, nil
+}
@@ -0,0 +1,307 @@
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func productFactors(t *testing.T, gen func() *G) (*G, *G) {
+	g1 := gen()
+
+	addVerts(t, g1, vA, vB)
+	addEdges(t, g1, edge{vA, vB, 1})
+
+	g2 := gen()
+
+	addVerts(t, g2, vC, vD, vE)
+	addEdges(t, g2, []edge{
+		{vC, vD, 2},
+		{vD, vE, 3},
+	}...)
+
+	return g1, g2
+}
+
+func TestProducts(t *testing.T) {
+	cases := []struct {
+		desc    string
+		op      func(g1, g2 Graph) (*G, error)
+		dCount  int
+		udCount int
+	}{
+		{
+			desc:    "cartesian",
+			op:      CartesianProduct,
+			dCount:  7,
+			udCount: 7,
+		},
+		{
+			desc:    "tensor",
+			op:      TensorProduct,
+			dCount:  2,
+			udCount: 4,
+		},
+		{
+			desc:    "strong",
+			op:      StrongProduct,
+			dCount:  9,
+			udCount: 11,
+		},
+	}
+
+	for _, tc := range cases {
+		for gType, gen := range graphGen {
+			t.Run(tagGraphTest(gType, tc.desc), func(t *testing.T) {
+				g1, g2 := productFactors(t, gen)
+
+				res, err := tc.op(g1, g2)
+
+				ut.Nil(t, err)
+				ut.Equal(t, g1.Directed(), res.Directed())
+				ut.False(t, res.IsMultigraph())
+
+				ut.Equal(t, 6, res.VertexCount())
+
+				if res.Directed() {
+					ut.Equal(t, tc.dCount, res.EdgeCount())
+				} else {
+					ut.Equal(t, tc.udCount, res.EdgeCount())
+					checkLinks(t, res)
+				}
+
+				// (u, v) is at index u * |V2| + v
+				ut.Equal(t, "(a, c)", res.V[0].Item.Label())
+				ut.Equal(t, "(b, d)", res.V[4].Item.Label())
+
+				p := res.V[4].Item.(*Pair)
+
+				ut.True(t, p.First == g1.Item(1))
+				ut.True(t, p.Second == g2.Item(1))
+			})
+		}
+	}
+}
+
+func TestProducts_weights(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1, g2 := productFactors(t, gen)
+
+			res, err := StrongProduct(g1, g2)
+
+			ut.Nil(t, err)
+
+			// (a, c) -> (b, c), from the first graph
+			_, e, ok := res.EdgeIndex(res.V[0].Item, res.V[3].Item)
+			ut.True(t, ok)
+			ut.Equal(t, 1.0, res.V[0].E[e].Wt)
+
+			// (a, c) -> (a, d), from the second graph
+			_, e, ok = res.EdgeIndex(res.V[0].Item, res.V[1].Item)
+			ut.True(t, ok)
+			ut.Equal(t, 2.0, res.V[0].E[e].Wt)
+
+			// (a, c) -> (b, d), from both graphs
+			_, e, ok = res.EdgeIndex(res.V[0].Item, res.V[4].Item)
+			ut.True(t, ok)
+			ut.Equal(t, 3.0, res.V[0].E[e].Wt)
+
+			// (a, d) -> (b, c), only in undirected graphs
+			_, _, ok = res.EdgeIndex(res.V[1].Item, res.V[3].Item)
+			ut.Equal(t, res.Undirected(), ok)
+		})
+	}
+}
+
+func TestProducts_multigraph(t *testing.T) {
+	g1 := NewGraphWith(Multigraph())
+
+	addVerts(t, g1, vA, vB)
+	addEdges(t, g1, []edge{
+		{vA, vB, 1},
+		{vA, vB, 2},
+	}...)
+
+	g2 := NewGraph()
+
+	addVerts(t, g2, vC, vD)
+	addEdges(t, g2, edge{vC, vD, 3})
+
+	res, err := CartesianProduct(g1, g2)
+
+	ut.Nil(t, err)
+	ut.True(t, res.IsMultigraph())
+	ut.Equal(t, 6, res.EdgeCount())
+
+	checkLinks(t, res)
+}
+
+func TestProducts_loops(t *testing.T) {
+	g1 := NewDigraph()
+
+	addVerts(t, g1, vA, vB)
+	addEdges(t, g1, edge{vA, vB, 1})
+
+	g2 := NewDigraph()
+
+	addVerts(t, g2, vC)
+	addEdges(t, g2, edge{vC, vC, 2})
+
+	res, err := StrongProduct(g1, g2)
+
+	ut.Nil(t, err)
+	ut.True(t, res.IsMultigraph())
+
+	// (a, c) -> (b, c) comes from both parts, along with two loops
+	ut.Equal(t, 4, res.EdgeCount())
+
+	wts := []float64{}
+
+	for _, e := range res.V[0].E {
+		if e.Dst == 1 {
+			wts = append(wts, e.Wt)
+		}
+	}
+
+	ut.Equal(t, 2, len(wts))
+	ut.Equal(t, 1.0, wts[0])
+	ut.Equal(t, 3.0, wts[1])
+
+	// loops in both graphs make Cartesian edges collide
+	res, err = CartesianProduct(g2, g2)
+
+	ut.Nil(t, err)
+	ut.True(t, res.IsMultigraph())
+	ut.Equal(t, 2, res.EdgeCount())
+
+	// loops in a single graph do not
+	res, err = CartesianProduct(g1, g2)
+
+	ut.Nil(t, err)
+	ut.False(t, res.IsMultigraph())
+	ut.Equal(t, 3, res.EdgeCount())
+
+	res, err = TensorProduct(g1, g2)
+
+	ut.Nil(t, err)
+	ut.False(t, res.IsMultigraph())
+	ut.Equal(t, 1, res.EdgeCount())
+}
+
+func TestLineGraph_legacy(t *testing.T) {
+	g := NewGraphWith(LegacyUndirected())
+
+	// each edge is listed from only one of its vertices
+	addVerts(t, g, vA, vB, vC)
+
+	_, _, err := g.AddEdge(vB, vA, 0)
+	ut.Nil(t, err)
+
+	_, _, err = g.AddEdge(vC, vB, 0)
+	ut.Nil(t, err)
+
+	res, es, err := LineGraph(g)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 2, len(es))
+	ut.Equal(t, 2, res.VertexCount())
+	ut.Equal(t, 1, res.EdgeCount())
+}
+
+func TestProducts_mixed(t *testing.T) {
+	_, err := CartesianProduct(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+
+	_, err = TensorProduct(NewDigraph(), NewGraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+
+	_, err = StrongProduct(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+}
+
+func TestLineGraph_directed(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vB, vC, 2},
+		{vB, vA, 3},
+	}...)
+
+	res, es, err := LineGraph(g)
+
+	ut.Nil(t, err)
+
+	ut.True(t, res.Directed())
+	ut.Equal(t, 3, res.VertexCount())
+	ut.Equal(t, 3, res.EdgeCount())
+
+	ut.Equal(t, 3, len(es))
+	ut.Equal(t, 2.0, es[1].Wt)
+
+	ut.Equal(t, "(a, b)", res.V[0].Item.Label())
+	ut.Equal(t, "(b, c)", res.V[1].Item.Label())
+	ut.Equal(t, "(b, a)", res.V[2].Item.Label())
+
+	ut.Equal(t, 2, len(res.V[0].E))
+	ut.Equal(t, 0, len(res.V[1].E))
+	ut.Equal(t, 1, len(res.V[2].E))
+	ut.Equal(t, 0, res.V[2].E[0].Dst)
+}
+
+func TestLineGraph_undirected(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB, vC, vD)
+	addEdges(t, g, []edge{
+		{vB, vA, 1},
+		{vB, vC, 2},
+		{vD, vB, 3},
+		{vC, vD, 4},
+	}...)
+
+	res, es, err := LineGraph(g)
+
+	ut.Nil(t, err)
+
+	ut.True(t, res.Undirected())
+	ut.Equal(t, 4, res.VertexCount())
+	ut.Equal(t, 4, len(es))
+
+	// every copy leaves its vertex with the lowest index
+	for _, e := range es {
+		ut.True(t, e.Src < e.Dst)
+	}
+
+	// 3 pairs of edges share b, 1 shares c and 1 shares d
+	ut.Equal(t, 5, res.EdgeCount())
+
+	checkLinks(t, res)
+}
+
+func TestLineGraph_multigraph(t *testing.T) {
+	g := NewGraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vA, vB, 2},
+		{vB, vC, 3},
+	}...)
+
+	res, _, err := LineGraph(g)
+
+	ut.Nil(t, err)
+
+	ut.False(t, res.IsMultigraph())
+	ut.Equal(t, 3, res.VertexCount())
+
+	// parallel edges are only connected once
+	ut.Equal(t, 3, res.EdgeCount())
+
+	checkLinks(t, res)
+}
@@ -0,0 +1,207 @@
+package ds
+
+/*
+contract creates the graph that results from contracting the vertices of g, where part maps every vertex
+of g to the index of the vertex it is contracted into, which holds the Item at the same index in items.
+Edges between vertices that are contracted together are dropped, and unless g is a multigraph, edges
+between the same contracted vertices are merged into a single edge, with the smallest weight among them.
+*/
+func contract(g Graph, part []int, items []Item) (*G, error) {
+	multi := isMulti(g)
+
+	opts := []GraphOpt{}
+
+	if multi {
+		opts = append(opts, Multigraph())
+	}
+
+	res := newG(g.Directed(), opts)
+
+	for _, i := range items {
+		if _, err := res.AddVertex(i); err != nil {
+			return nil, err
+		}
+	}
+
+	es := []setEdge{}
+
+	// at maps a pair of contracted vertices to their edge in es
+	at := map[[2]int]int{}
+
+	forEachOnce(g, func(e GE) {
+		src, dst := part[e.Src], part[e.Dst]
+
+		if src == dst {
+			return
+		}
+
+		if multi {
+			es = append(es, setEdge{src, dst, e.Wt})
+			return
+		}
+
+		k := [2]int{src, dst}
+
+		if g.Undirected() && dst < src {
+			k = [2]int{dst, src}
+		}
+
+		if idx, ok := at[k]; ok {
+			if e.Wt < es[idx].wt {
+				es[idx].wt = e.Wt
+			}
+
+			return
+		}
+
+		at[k] = len(es)
+		es = append(es, setEdge{src, dst, e.Wt})
+	})
+
+	for _, e := range es {
+		if _, _, err := res.AddEdge(items[e.src], items[e.dst], e.wt); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+/*
+ContractEdge creates the graph that results from contracting the edge between the vertices associated with
+the given Items: both vertices are merged into a single one, which takes the place of the vertex of u, and
+holds a Group with u and v as its Items, with the Group id being the index of the merged vertex.
+
+Every edge between u and v is dropped, and every other edge of u or v now leaves from, or arrives at, the
+merged vertex. Unless the graph is a multigraph, edges that end up between the same vertices are merged
+into a single edge, with the smallest weight among them.
+
+If any of the Items is not associated with a vertex, ErrNoVtx is returned, and if there is no edge
+between them, ErrNoEdge is returned.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func ContractEdge(g Graph, u, v Item) (*G, error) {
+	iU, ok := g.VertexIndex(u)
+
+	if !ok {
+		return nil, ErrNoVtx
+	}
+
+	iV, ok := g.VertexIndex(v)
+
+	if !ok {
+		return nil, ErrNoVtx
+	}
+
+	if iU == iV {
+		return nil, ErrInvLoop
+	}
+
+	found := false
+
+	find := func(dst int) func(GE) {
+		return func(e GE) {
+			if e.Dst == dst {
+				found = true
+			}
+		}
+	}
+
+	g.ForEachEdge(iU, find(iV))
+
+	if !found && g.Directed() {
+		g.ForEachEdge(iV, find(iU))
+	}
+
+	if !found {
+		return nil, ErrNoEdge
+	}
+
+	part := make([]int, g.VertexCount())
+	items := []Item{}
+
+	for w := range part {
+		if w == iV {
+			continue
+		}
+
+		part[w] = len(items)
+
+		if w == iU {
+			items = append(items, &Group{
+				Items: []Item{u, v},
+				Id:    len(items),
+			})
+		} else {
+			items = append(items, g.Item(w))
+		}
+	}
+
+	part[iV] = part[iU]
+
+	return contract(g, part, items)
+}
+
+/*
+Quotient creates the quotient graph of a graph, given a partition of its vertices: a list of parts, each one
+listing the indexes of its vertices, with every vertex in exactly one part (e.g.: the output of algo.CCDFS).
+Each part is contracted into a vertex of the quotient graph, at the same index, holding a Group with the
+Items of its vertices, in the same order, with the Group id being the index of the part.
+
+There is an edge from part p to part q if there is at least one edge from a vertex in p to a vertex in q,
+with edges inside the same part being dropped. Unless the graph is a multigraph, the edge has the smallest
+weight among the edges between both parts, otherwise each edge is kept as a parallel edge.
+
+If any index does not belong to a vertex, ErrNoVtx is returned, and if any part is empty, or any vertex
+is in no parts, or in more than one part, ErrInvPartition is returned.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Quotient[P ~[]int](g Graph, partition []P) (*G, error) {
+	part := make([]int, g.VertexCount())
+
+	for v := range part {
+		part[v] = -1
+	}
+
+	items := make([]Item, len(partition))
+
+	for id, p := range partition {
+		if len(p) == 0 {
+			return nil, ErrInvPartition
+		}
+
+		group := &Group{
+			Items: make([]Item, len(p)),
+			Id:    id,
+		}
+
+		for i, v := range p {
+			if v < 0 || v >= len(part) {
+				return nil, ErrNoVtx
+			}
+
+			if part[v] != -1 {
+				return nil, ErrInvPartition
+			}
+
+			part[v] = id
+			group.Items[i] = g.Item(v)
+		}
+
+		items[id] = group
+	}
+
+	for v := range part {
+		if part[v] == -1 {
+			return nil, ErrInvPartition
+		}
+	}
+
+	return contract(g, part, items)
+}
@@ -0,0 +1,180 @@
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+type testPart []int
+
+func TestQuotient(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vB, vC, 3},
+				{vA, vC, 2},
+				{vC, vD, 5},
+			}...)
+
+			res, err := Quotient(g, []testPart{{1, 0}, {2}, {3}})
+
+			ut.Nil(t, err)
+			ut.Equal(t, g.Directed(), res.Directed())
+
+			ut.Equal(t, 3, res.VertexCount())
+			ut.Equal(t, 2, res.EdgeCount())
+
+			for id := range res.V {
+				grp := res.V[id].Item.(*Group)
+				ut.Equal(t, id, grp.Id)
+			}
+
+			grp := res.V[0].Item.(*Group)
+
+			ut.Equal(t, 2, len(grp.Items))
+			ut.True(t, grp.Items[0] == vB)
+			ut.True(t, grp.Items[1] == vA)
+
+			// the lightest edge between parts is kept
+			ut.Equal(t, 1, len(res.V[0].E))
+			ut.Equal(t, 1, res.V[0].E[0].Dst)
+			ut.Equal(t, 2.0, res.V[0].E[0].Wt)
+
+			if res.Undirected() {
+				checkLinks(t, res)
+			}
+		})
+	}
+}
+
+func TestQuotient_multigraph(t *testing.T) {
+	g := NewDigraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vC, 1},
+		{vB, vC, 2},
+		{vA, vB, 3},
+	}...)
+
+	res, err := Quotient(g, [][]int{{0, 1}, {2}})
+
+	ut.Nil(t, err)
+	ut.True(t, res.IsMultigraph())
+	ut.Equal(t, 2, res.EdgeCount())
+	ut.Equal(t, 2, len(res.V[0].E))
+}
+
+func TestQuotient_legacy(t *testing.T) {
+	g := NewGraphWith(LegacyUndirected())
+
+	// the edge is listed from its last vertex only
+	addVerts(t, g, vA, vB, vC)
+
+	g.AddEdge(vC, vA, 4)
+
+	res, err := Quotient(g, [][]int{{0, 1}, {2}})
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, res.EdgeCount())
+	ut.Equal(t, 4.0, res.V[0].E[0].Wt)
+}
+
+func TestQuotient_invalid(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB, vC)
+
+	cases := []struct {
+		desc   string
+		parts  [][]int
+		expect error
+	}{
+		{
+			desc:   "empty part",
+			parts:  [][]int{{0, 1, 2}, {}},
+			expect: ErrInvPartition,
+		},
+		{
+			desc:   "repeated vertex",
+			parts:  [][]int{{0, 1}, {1, 2}},
+			expect: ErrInvPartition,
+		},
+		{
+			desc:   "missing vertex",
+			parts:  [][]int{{0, 2}},
+			expect: ErrInvPartition,
+		},
+		{
+			desc:   "no vertex",
+			parts:  [][]int{{0, 1, 2}, {3}},
+			expect: ErrNoVtx,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := Quotient(g, tc.parts)
+			ut.True(t, errors.Is(err, tc.expect))
+		})
+	}
+}
+
+func TestContractEdge(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vA, vC, 2},
+				{vC, vB, 3},
+				{vC, vD, 4},
+			}...)
+
+			res, err := ContractEdge(g, vC, vB)
+
+			ut.Nil(t, err)
+
+			ut.Equal(t, 3, res.VertexCount())
+			ut.Equal(t, 2, res.EdgeCount())
+
+			// the merged vertex takes the place of c
+			ut.True(t, res.V[0].Item == vA)
+			ut.True(t, res.V[2].Item == vD)
+
+			grp := res.V[1].Item.(*Group)
+
+			ut.Equal(t, 1, grp.Id)
+			ut.True(t, grp.Items[0] == vC)
+			ut.True(t, grp.Items[1] == vB)
+
+			_, e, ok := res.EdgeIndex(vA, grp)
+			ut.True(t, ok)
+			ut.Equal(t, 1.0, res.V[0].E[e].Wt)
+
+			_, _, ok = res.EdgeIndex(grp, vD)
+			ut.True(t, ok)
+
+			if res.Undirected() {
+				checkLinks(t, res)
+			}
+
+			_, err = ContractEdge(g, vA, vD)
+			ut.True(t, errors.Is(err, ErrNoEdge))
+
+			_, err = ContractEdge(g, vA, vE)
+			ut.True(t, errors.Is(err, ErrNoVtx))
+
+			_, err = ContractEdge(g, vA, vA)
+			ut.True(t, errors.Is(err, ErrInvLoop))
+		})
+	}
+}
@@ -0,0 +1,468 @@
+package ds
+
+/*
+A WeightPolicy decides the weight of an edge that exists in two graphs being combined, given its weight
+in the first graph and in the second one, which are different. Returning an error aborts the operation.
+*/
+type WeightPolicy func(wt1, wt2 float64) (float64, error)
+
+// KeepFirst is a WeightPolicy that keeps the weight of the edge in the first graph.
+func KeepFirst(wt1, wt2 float64) (float64, error) {
+	return wt1, nil
+}
+
+// KeepSecond is a WeightPolicy that keeps the weight of the edge in the second graph.
+func KeepSecond(wt1, wt2 float64) (float64, error) {
+	return wt2, nil
+}
+
+// KeepMin is a WeightPolicy that keeps the smallest weight.
+func KeepMin(wt1, wt2 float64) (float64, error) {
+	if wt2 < wt1 {
+		return wt2, nil
+	}
+
+	return wt1, nil
+}
+
+// KeepMax is a WeightPolicy that keeps the largest weight.
+func KeepMax(wt1, wt2 float64) (float64, error) {
+	if wt2 > wt1 {
+		return wt2, nil
+	}
+
+	return wt1, nil
+}
+
+// RejectConflicts is a WeightPolicy that aborts the operation, returning ErrWtConflict.
+func RejectConflicts(wt1, wt2 float64) (float64, error) {
+	return 0, ErrWtConflict
+}
+
+// setCfg holds the configuration of a set operation.
+type setCfg struct {
+	key    func(Item) any
+	policy WeightPolicy
+}
+
+// A SetOpt configures how a set operation (Union, Intersection, Difference) combines two graphs.
+type SetOpt func(*setCfg)
+
+/*
+MatchBy makes a set operation match vertices by a key calculated from their labels, instead of by their
+Items, so vertices of different graphs can be matched even if their Items are different values.
+*/
+func MatchBy(key func(label string) string) SetOpt {
+	return func(c *setCfg) {
+		c.key = func(i Item) any {
+			return key(i.Label())
+		}
+	}
+}
+
+// OnConflict sets the WeightPolicy used by a set operation. By default, KeepFirst is used.
+func OnConflict(p WeightPolicy) SetOpt {
+	return func(c *setCfg) {
+		c.policy = p
+	}
+}
+
+// setEdge is an edge of one of the graphs being combined, between merged vertices.
+type setEdge struct {
+	src, dst int
+	wt       float64
+}
+
+// setVtx is a merged vertex, with its index in each of the graphs being combined, -1 if absent.
+type setVtx struct {
+	item   Item
+	v1, v2 int
+}
+
+// setOp holds the merged vertices and the matched edges of two graphs being combined.
+type setOp struct {
+	cfg setCfg
+	dir bool
+	vs  []setVtx
+
+	es1, es2 []setEdge
+
+	// match1[i] is the edge of the second graph matched with
+	// the edge i of the first graph, -1 if there is none
+	match1 []int
+
+	// match2[j] is whether or not the edge j of the second
+	// graph was matched with an edge of the first graph
+	match2 []bool
+}
+
+/*
+newSetOp merges the vertices of two graphs by key, and matches their edges: the k-th edge between
+two merged vertices in the first graph is matched with the k-th edge between them in the second one.
+*/
+func newSetOp(g1, g2 Graph, opts []SetOpt) (*setOp, error) {
+	if g1.Directed() != g2.Directed() {
+		return nil, ErrMixedGraphs
+	}
+
+	op := &setOp{dir: g1.Directed()}
+
+	op.cfg.key = func(i Item) any { return i }
+	op.cfg.policy = KeepFirst
+
+	for _, opt := range opts {
+		opt(&op.cfg)
+	}
+
+	merged := map[any]int{}
+
+	for v := 0; v < g1.VertexCount(); v++ {
+		k := op.cfg.key(g1.Item(v))
+
+		if _, ok := merged[k]; ok {
+			return nil, ErrDupKey
+		}
+
+		merged[k] = len(op.vs)
+		op.vs = append(op.vs, setVtx{g1.Item(v), v, -1})
+	}
+
+	m2 := make([]int, g2.VertexCount())
+
+	for v := range m2 {
+		k := op.cfg.key(g2.Item(v))
+
+		idx, ok := merged[k]
+
+		switch {
+
+		case !ok:
+			idx = len(op.vs)
+			merged[k] = idx
+			op.vs = append(op.vs, setVtx{g2.Item(v), -1, v})
+
+		case op.vs[idx].v2 != -1:
+			return nil, ErrDupKey
+
+		default:
+			op.vs[idx].v2 = v
+		}
+
+		m2[v] = idx
+	}
+
+	// the vertices of the first graph were merged in order
+	m1 := make([]int, g1.VertexCount())
+
+	for v := range m1 {
+		m1[v] = v
+	}
+
+	op.es1 = op.edges(g1, m1)
+	op.es2 = op.edges(g2, m2)
+
+	pending := map[[2]int][]int{}
+
+	for j, e := range op.es2 {
+		k := op.pair(e)
+		pending[k] = append(pending[k], j)
+	}
+
+	op.match1 = make([]int, len(op.es1))
+	op.match2 = make([]bool, len(op.es2))
+
+	for i, e := range op.es1 {
+		k := op.pair(e)
+		op.match1[i] = -1
+
+		if q := pending[k]; len(q) != 0 {
+			op.match1[i] = q[0]
+			op.match2[q[0]] = true
+
+			pending[k] = q[1:]
+		}
+	}
+
+	return op, nil
+}
+
+// edges lists the edges of a graph between merged vertices, with every undirected edge listed once (see forEachOnce).
+func (op *setOp) edges(g Graph, merged []int) []setEdge {
+	res := []setEdge{}
+
+	forEachOnce(g, func(e GE) {
+		res = append(res, setEdge{merged[e.Src], merged[e.Dst], e.Wt})
+	})
+
+	return res
+}
+
+/*
+forEachOnce calls fn for every edge of a graph, in order, with every undirected edge listed once, by its first copy.
+Copies that are linked (see GE.Rev) are recognized through their links, while copies of graphs that do not link
+them (e.g.: created using LegacyUndirected) are paired like the TextParser pairs them: the k-th copy of (u, v)
+with the k-th copy of (v, u), and any copy without a counterpart is listed as an edge of its own.
+*/
+func forEachOnce(g Graph, fn func(GE)) {
+	linked := linksCopies(g)
+
+	// listed holds the positions of the reverse copies of the edges listed
+	// so far, for graphs that link copies, and unpaired counts the copies
+	// of (u, v) that were listed, but not paired yet, for the other ones
+	listed := map[[2]int]bool{}
+	unpaired := map[[2]int]int{}
+
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e GE) {
+			switch {
+
+			// directed edges have no copies
+			case g.Directed():
+
+			case linked:
+				if listed[[2]int{e.Src, e.Index}] {
+					return
+				}
+
+				listed[[2]int{e.Dst, e.Rev}] = true
+
+			default:
+				if rev := [2]int{e.Dst, e.Src}; unpaired[rev] != 0 {
+					unpaired[rev]--
+					return
+				}
+
+				unpaired[[2]int{e.Src, e.Dst}]++
+			}
+
+			fn(e)
+		})
+	}
+}
+
+// linksCopies checks whether or not a graph links the copies of its undirected edges (see GE.Rev).
+func linksCopies(g Graph) bool {
+	switch x := g.(type) {
+
+	case *G:
+		return x.linked()
+
+	case *M:
+		return x.Undirected()
+	}
+
+	return false
+}
+
+// pair identifies the pair of merged vertices of an edge, regardless of its direction, if undirected.
+func (op *setOp) pair(e setEdge) [2]int {
+	if !op.dir && e.dst < e.src {
+		return [2]int{e.dst, e.src}
+	}
+
+	return [2]int{e.src, e.dst}
+}
+
+// weight calculates the weight of a pair of matched edges.
+func (op *setOp) weight(e1, e2 setEdge) (float64, error) {
+	if e1.wt == e2.wt {
+		return e1.wt, nil
+	}
+
+	return op.cfg.policy(e1.wt, e2.wt)
+}
+
+// build creates a graph holding the merged vertices that are kept, along with the given edges.
+func (op *setOp) build(keep func(setVtx) bool, es []setEdge, multi bool) (*G, error) {
+	opts := []GraphOpt{}
+
+	if multi {
+		opts = append(opts, Multigraph())
+	}
+
+	res := newG(op.dir, opts)
+
+	for _, v := range op.vs {
+		if !keep(v) {
+			continue
+		}
+
+		if _, err := res.AddVertex(v.item); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, e := range es {
+		if _, _, err := res.AddEdge(op.vs[e.src].item, op.vs[e.dst].item, e.wt); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// isMulti checks whether or not any of the given graphs is a multigraph.
+func isMulti(gs ...Graph) bool {
+	for _, g := range gs {
+		if mg, ok := g.(*G); ok && mg.multi {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Union creates the union of two graphs, which must be either both directed or both undirected. Vertices are
+matched by their Items, unless MatchBy is used, with each vertex of the union holding the Item from the first
+graph where it appears. Every edge of either graph is in the union, and edges that exist in both graphs, but
+with different weights, have their weight decided by a WeightPolicy (see OnConflict).
+
+In multigraphs, the k-th edge between two vertices in one graph is matched with the k-th edge between
+the same vertices in the other graph, so the union holds as many edges between two vertices as the
+graph where they have the most edges between them.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Union(g1, g2 Graph, opts ...SetOpt) (*G, error) {
+	op, err := newSetOp(g1, g2, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	es := []setEdge{}
+
+	for i, e := range op.es1 {
+		if j := op.match1[i]; j != -1 {
+			if e.wt, err = op.weight(e, op.es2[j]); err != nil {
+				return nil, err
+			}
+		}
+
+		es = append(es, e)
+	}
+
+	for j, e := range op.es2 {
+		if !op.match2[j] {
+			es = append(es, e)
+		}
+	}
+
+	all := func(setVtx) bool { return true }
+
+	return op.build(all, es, isMulti(g1, g2))
+}
+
+/*
+Intersection creates the intersection of two graphs, which must be either both directed or both undirected:
+the graph that holds only the vertices and the edges that exist in both of them. Vertices and edges are
+matched like in Union, and so are the weights of edges decided.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Intersection(g1, g2 Graph, opts ...SetOpt) (*G, error) {
+	op, err := newSetOp(g1, g2, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	es := []setEdge{}
+
+	for i, e := range op.es1 {
+		j := op.match1[i]
+
+		if j == -1 {
+			continue
+		}
+
+		if e.wt, err = op.weight(e, op.es2[j]); err != nil {
+			return nil, err
+		}
+
+		es = append(es, e)
+	}
+
+	both := func(v setVtx) bool { return v.v1 != -1 && v.v2 != -1 }
+
+	return op.build(both, es, isMulti(g1, g2))
+}
+
+/*
+Difference creates the difference between two graphs, which must be either both directed or both undirected:
+the graph that holds every vertex of the first graph, but only the edges of the first graph that do not exist
+in the second one. Vertices and edges are matched like in Union, but regardless of their weights.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func Difference(g1, g2 Graph, opts ...SetOpt) (*G, error) {
+	op, err := newSetOp(g1, g2, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	es := []setEdge{}
+
+	for i, e := range op.es1 {
+		if op.match1[i] == -1 {
+			es = append(es, e)
+		}
+	}
+
+	first := func(v setVtx) bool { return v.v1 != -1 }
+
+	return op.build(first, es, isMulti(g1))
+}
+
+/*
+Complement creates the complement of a graph: the graph with the same vertices, where there is an edge
+between two distinct vertices if and only if there is no edge between them in the original graph.
+Edges of the complement have no weight, and the complement is never a multigraph.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: Θ(V²)
+*/
+func Complement(g Graph) *G {
+	n := g.VertexCount()
+	adj := make([][]bool, n)
+
+	for v := range adj {
+		adj[v] = make([]bool, n)
+
+		g.ForEachEdge(v, func(e GE) {
+			adj[e.Src][e.Dst] = true
+		})
+	}
+
+	res := newG(g.Directed(), nil)
+
+	for v := 0; v < n; v++ {
+		res.AddVertex(g.Item(v))
+	}
+
+	for v := 0; v < n; v++ {
+		for u := 0; u < n; u++ {
+			if u == v || adj[v][u] {
+				continue
+			}
+
+			// undirected edges are only added once
+			if g.Undirected() && u < v {
+				continue
+			}
+
+			res.AddEdge(g.Item(v), g.Item(u), 0)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,251 @@
+package ds
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// setGraphs builds two overlapping graphs: {a, b, c} and {b, c, d}.
+func setGraphs(t *testing.T, gen func() *G) (*G, *G) {
+	g1, g2 := gen(), gen()
+
+	addVerts(t, g1, vA, vB, vC)
+	addEdges(t, g1, []edge{
+		{vA, vB, 1},
+		{vB, vC, 2},
+		{vC, vA, 3},
+	}...)
+
+	addVerts(t, g2, vB, vC, vD)
+	addEdges(t, g2, []edge{
+		{vB, vC, 5},
+		{vC, vD, 4},
+	}...)
+
+	return g1, g2
+}
+
+func edgeWt(t *testing.T, g *G, src, dst Item) float64 {
+	v, e, ok := g.EdgeIndex(src, dst)
+
+	ut.True(t, ok)
+
+	return g.V[v].E[e].Wt
+}
+
+func TestUnion(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1, g2 := setGraphs(t, gen)
+
+			u, err := Union(g1, g2)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 4, u.VertexCount())
+			ut.Equal(t, 4, u.EdgeCount())
+
+			ut.True(t, u.V[3].Item == vD)
+			ut.Equal(t, 2.0, edgeWt(t, u, vB, vC))
+			ut.Equal(t, 4.0, edgeWt(t, u, vC, vD))
+
+			u, err = Union(g1, g2, OnConflict(KeepMax))
+
+			ut.Nil(t, err)
+			ut.Equal(t, 5.0, edgeWt(t, u, vB, vC))
+
+			_, err = Union(g1, g2, OnConflict(RejectConflicts))
+			ut.True(t, errors.Is(err, ErrWtConflict))
+		})
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1, g2 := setGraphs(t, gen)
+
+			i, err := Intersection(g1, g2, OnConflict(KeepSecond))
+
+			ut.Nil(t, err)
+			ut.Equal(t, 2, i.VertexCount())
+			ut.Equal(t, 1, i.EdgeCount())
+
+			ut.Equal(t, 5.0, edgeWt(t, i, vB, vC))
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g1, g2 := setGraphs(t, gen)
+
+			d, err := Difference(g1, g2)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 3, d.VertexCount())
+			ut.Equal(t, 2, d.EdgeCount())
+
+			_, _, ok := d.EdgeIndex(vB, vC)
+			ut.False(t, ok)
+		})
+	}
+}
+
+func TestSetOps_undirected_reversed(t *testing.T) {
+	g1, g2 := NewGraph(), NewGraph()
+
+	addVerts(t, g1, vA, vB)
+	addEdges(t, g1, edge{vA, vB, 1})
+
+	// the same edge, listed from the other vertex first
+	addVerts(t, g2, vB, vA)
+	addEdges(t, g2, edge{vB, vA, 1})
+
+	u, err := Union(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, u.EdgeCount())
+
+	d, err := Difference(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, d.EdgeCount())
+}
+
+func TestSetOps_legacy(t *testing.T) {
+	g1, g2 := NewGraphWith(LegacyUndirected()), NewGraph()
+
+	// each edge is listed from only one of its vertices,
+	// and the one from c is listed after its destination
+	addVerts(t, g1, vA, vB, vC)
+
+	g1.AddEdge(vA, vB, 1)
+	g1.AddEdge(vC, vA, 2)
+	g1.AddEdge(vB, vC, 3)
+	g1.AddEdge(vC, vB, 3)
+
+	addVerts(t, g2, vA)
+
+	u, err := Union(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 3, u.EdgeCount())
+
+	ut.Equal(t, 1.0, edgeWt(t, u, vA, vB))
+	ut.Equal(t, 2.0, edgeWt(t, u, vA, vC))
+	ut.Equal(t, 3.0, edgeWt(t, u, vB, vC))
+
+	d, err := Difference(g1, u)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, d.EdgeCount())
+
+	i, err := Intersection(u, g1)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 3, i.EdgeCount())
+}
+
+func TestSetOps_multigraph(t *testing.T) {
+	g1, g2 := NewDigraphWith(Multigraph()), NewDigraph()
+
+	addVerts(t, g1, vA, vB)
+	addEdges(t, g1, []edge{{vA, vB, 1}, {vA, vB, 2}}...)
+
+	addVerts(t, g2, vA, vB)
+	addEdges(t, g2, edge{vA, vB, 1})
+
+	u, err := Union(g1, g2)
+
+	ut.Nil(t, err)
+	ut.True(t, u.IsMultigraph())
+	ut.Equal(t, 2, u.EdgeCount())
+
+	i, err := Intersection(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, i.EdgeCount())
+
+	d, err := Difference(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, d.EdgeCount())
+	ut.Equal(t, 2.0, d.V[0].E[0].Wt)
+}
+
+func TestSetOps_match_by(t *testing.T) {
+	g1, g2 := NewDigraph(), NewDigraph()
+
+	addVerts(t, g1, Text("a"), Text("b"))
+	addEdges(t, g1, edge{Text("a"), Text("b"), 1})
+
+	addVerts(t, g2, Text("A"), Text("B"))
+	addEdges(t, g2, edge{Text("B"), Text("A"), 1})
+
+	u, err := Union(g1, g2)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 4, u.VertexCount())
+
+	u, err = Union(g1, g2, MatchBy(strings.ToLower))
+
+	ut.Nil(t, err)
+	ut.Equal(t, 2, u.VertexCount())
+	ut.Equal(t, 2, u.EdgeCount())
+
+	// the Items of the first graph are kept
+	ut.True(t, u.V[0].Item == Text("a"))
+
+	addVerts(t, g2, Text("b"))
+
+	_, err = Union(g1, g2, MatchBy(strings.ToLower))
+	ut.True(t, errors.Is(err, ErrDupKey))
+}
+
+func TestSetOps_mixed(t *testing.T) {
+	_, err := Union(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+
+	_, err = Intersection(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+
+	_, err = Difference(NewGraph(), NewDigraph())
+	ut.True(t, errors.Is(err, ErrMixedGraphs))
+}
+
+func TestComplement(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC)
+			addEdges(t, g, edge{vA, vB, 1})
+
+			// loops are never part of the complement
+			if g.Directed() {
+				addEdges(t, g, edge{vA, vA, 1})
+			}
+
+			c := Complement(g)
+
+			ut.Equal(t, 3, c.VertexCount())
+
+			_, _, ok := c.EdgeIndex(vA, vB)
+			ut.False(t, ok)
+
+			_, _, ok = c.EdgeIndex(vA, vA)
+			ut.False(t, ok)
+
+			if c.Directed() {
+				ut.Equal(t, 5, c.EdgeCount())
+			} else {
+				ut.Equal(t, 2, c.EdgeCount())
+				checkLinks(t, c)
+			}
+		})
+	}
+}
@@ -28,6 +28,23 @@ func (z Group) Label() string {
 	return strconv.Itoa(z.Id)
 }
 
+/*
+Pair pairs two items of types that implement the Item interface, and also implements
+the Item interface itself, labeling itself after both items, so data structures that
+can hold Item implementations can also hold Pair values.
+
+Such a capability is useful for operations that create elements out of pairs of existing
+elements (e.g.: the vertices of a graph product, or the vertices of a line graph).
+*/
+type Pair struct {
+	First  Item
+	Second Item
+}
+
+func (p Pair) Label() string {
+	return "(" + p.First.Label() + ", " + p.Second.Label() + ")"
+}
+
 /*
 Cut removes an element from a slice at a given position. Memory leaks are avoided
 by assigning the zero value for the type of the element to the position that is
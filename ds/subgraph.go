@@ -0,0 +1,170 @@
+package ds
+
+/*
+copyOf creates a new graph that behaves like the given one, holding the vertices and edges added by fill.
+Undirected edges are added as they are listed, one copy at a time, and only linked once every copy has been
+added (see TextParser), so the adjacency lists of the new graph follow the order of the original lists.
+Copies of an edge of g with different weights (e.g.: edited through G.V) cannot be linked, in which case
+ErrWtConflict is returned.
+*/
+func copyOf(g Graph, fill func(res *G) error) (*G, error) {
+	opts := optsOf(g)
+
+	if g.Undirected() {
+		opts = append(opts, LegacyUndirected())
+	}
+
+	legacy := false
+
+	if mg, ok := g.(*G); ok {
+		legacy = mg.legacy
+	}
+
+	res := newG(g.Directed(), opts)
+
+	if err := fill(res); err != nil {
+		return nil, err
+	}
+
+	if !legacy {
+		if err := res.symmetrize(); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+/*
+induced creates the subgraph induced by the vertices that are kept: every edge between two kept vertices
+is kept as well. Vertices keep their relative order, and so do the edges leaving each vertex.
+*/
+func induced(g Graph, keep []bool) (*G, []int, error) {
+	orig := []int{}
+
+	res, err := copyOf(g, func(res *G) error {
+		for v := 0; v < g.VertexCount(); v++ {
+			if !keep[v] {
+				continue
+			}
+
+			if _, err := res.AddVertex(g.Item(v)); err != nil {
+				return err
+			}
+
+			orig = append(orig, v)
+		}
+
+		return copyEdges(g, res, orig, func(e GE) bool {
+			return keep[e.Dst]
+		})
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, orig, nil
+}
+
+// copyEdges adds to res the edges of g that leave the given vertices and are accepted by pred, in order.
+func copyEdges(g Graph, res *G, vs []int, pred func(GE) bool) error {
+	var err error
+
+	for _, v := range vs {
+		g.ForEachEdge(v, func(e GE) {
+			if err != nil || !pred(e) {
+				return
+			}
+
+			_, _, err = res.AddEdge(g.Item(e.Src), g.Item(e.Dst), e.Wt)
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+InducedSubgraph creates the subgraph of g induced by the vertices associated with the given Items:
+the subgraph holds these vertices, and every edge of g whose vertices are both among them.
+Vertices keep their relative order in g, and so do the edges leaving each vertex.
+
+Along with the subgraph, a mapping from the indexes of its vertices to their original indexes in g
+is returned. If any of the Items is not associated with a vertex of g, ErrNoVtx is returned, and if the
+copies of an undirected edge of g have different weights, ErrWtConflict is returned.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func InducedSubgraph(g Graph, is []Item) (*G, []int, error) {
+	keep := make([]bool, g.VertexCount())
+
+	for _, i := range is {
+		v, ok := g.VertexIndex(i)
+
+		if !ok {
+			return nil, nil, ErrNoVtx
+		}
+
+		keep[v] = true
+	}
+
+	return induced(g, keep)
+}
+
+/*
+FilterVertices creates the subgraph of g induced by the vertices accepted by pred (see InducedSubgraph),
+returning it along with a mapping from the indexes of its vertices to their original indexes in g.
+If the copies of an undirected edge of g have different weights, ErrWtConflict is returned.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func FilterVertices(g Graph, pred func(GV) bool) (*G, []int, error) {
+	keep := make([]bool, g.VertexCount())
+
+	for v := range keep {
+		keep[v] = pred(GV{Item: g.Item(v), Index: v})
+	}
+
+	return induced(g, keep)
+}
+
+/*
+EdgeSubgraph creates the subgraph of g that holds every vertex of g, but only the edges accepted
+by pred, returning it along with a mapping from the indexes of its vertices to their original
+indexes in g, which are the same, since every vertex is kept. In an undirected graph, an edge
+is kept if pred accepts any of its copies, and if its copies have different weights, ErrWtConflict
+is returned. Edges leaving each vertex keep their relative order.
+
+Complexity:
+	- Time:  Θ(V + E)
+	- Space: Θ(V + E)
+*/
+func EdgeSubgraph(g Graph, pred func(GE) bool) (*G, []int, error) {
+	orig := make([]int, g.VertexCount())
+
+	res, err := copyOf(g, func(res *G) error {
+		for v := range orig {
+			if _, err := res.AddVertex(g.Item(v)); err != nil {
+				return err
+			}
+
+			orig[v] = v
+		}
+
+		return copyEdges(g, res, orig, pred)
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, orig, nil
+}
@@ -0,0 +1,168 @@
+package ds
+
+import (
+	"errors"
+	"testing"
+
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestInducedSubgraph(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC, vD)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vD, vA, 2},
+				{vA, vC, 3},
+				{vB, vC, 4},
+				{vC, vD, 5},
+			}...)
+
+			sub, orig, err := InducedSubgraph(g, []Item{vD, vA, vC})
+
+			ut.Nil(t, err)
+			ut.Equal(t, g.Directed(), sub.Directed())
+
+			ut.Equal(t, 3, sub.VertexCount())
+			ut.Equal(t, 3, sub.EdgeCount())
+
+			ut.Equal(t, 3, len(orig))
+			ut.Equal(t, 0, orig[0])
+			ut.Equal(t, 2, orig[1])
+			ut.Equal(t, 3, orig[2])
+
+			for v := range sub.V {
+				ut.True(t, sub.V[v].Item == g.V[orig[v]].Item)
+			}
+
+			_, _, ok := sub.EdgeIndex(vA, vB)
+			ut.False(t, ok)
+
+			_, _, ok = sub.EdgeIndex(vD, vA)
+			ut.True(t, ok)
+
+			if sub.Undirected() {
+				checkLinks(t, sub)
+
+				// the order of the original lists is kept
+				ut.Equal(t, 2, sub.V[0].E[0].Dst)
+				ut.Equal(t, 1, sub.V[0].E[1].Dst)
+			}
+
+			_, _, err = InducedSubgraph(g, []Item{vA, vE})
+			ut.True(t, errors.Is(err, ErrNoVtx))
+		})
+	}
+}
+
+func TestFilterVertices(t *testing.T) {
+	g := NewGraphWith(Multigraph())
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vA, vB, 2},
+		{vB, vC, 3},
+	}...)
+
+	sub, orig, err := FilterVertices(g, func(v GV) bool {
+		return v.Item != vC
+	})
+
+	ut.Nil(t, err)
+
+	ut.True(t, sub.IsMultigraph())
+
+	ut.Equal(t, 2, sub.VertexCount())
+	ut.Equal(t, 2, sub.EdgeCount())
+	ut.Equal(t, 2, len(orig))
+
+	checkLinks(t, sub)
+}
+
+func TestEdgeSubgraph(t *testing.T) {
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			addVerts(t, g, vA, vB, vC)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vB, vC, 4},
+				{vC, vA, 5},
+			}...)
+
+			// only one copy of each undirected edge is accepted
+			sub, orig, err := EdgeSubgraph(g, func(e GE) bool {
+				return e.Wt > 2 && e.Src < e.Dst
+			})
+
+			ut.Nil(t, err)
+
+			ut.Equal(t, 3, sub.VertexCount())
+			ut.Equal(t, 3, len(orig))
+
+			if sub.Directed() {
+				ut.Equal(t, 1, sub.EdgeCount())
+			} else {
+				ut.Equal(t, 2, sub.EdgeCount())
+				checkLinks(t, sub)
+			}
+
+			_, _, ok := sub.EdgeIndex(vA, vB)
+			ut.False(t, ok)
+
+			_, _, ok = sub.EdgeIndex(vB, vC)
+			ut.True(t, ok)
+		})
+	}
+}
+
+func TestEdgeSubgraph_matrix(t *testing.T) {
+	m := NewMatrixGraph()
+
+	m.AddVertex(vA)
+	m.AddVertex(vB)
+	m.AddVertex(vC)
+
+	m.AddEdge(vA, vB, 1)
+	m.AddEdge(vB, vC, 2)
+
+	sub, _, err := EdgeSubgraph(m, func(e GE) bool {
+		return e.Wt == 2
+	})
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 1, sub.EdgeCount())
+	checkLinks(t, sub)
+}
+
+func TestSubgraph_conflict(t *testing.T) {
+	g := NewGraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1},
+		{vB, vC, 2},
+	}...)
+
+	// only one of the copies of (a, b) is reweighted
+	g.V[0].E[0].Wt = 3
+
+	_, _, err := InducedSubgraph(g, []Item{vA, vB})
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	_, _, err = FilterVertices(g, func(GV) bool { return true })
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	_, _, err = EdgeSubgraph(g, func(GE) bool { return true })
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	// the conflicting edge is not copied
+	_, _, err = InducedSubgraph(g, []Item{vB, vC})
+	ut.Nil(t, err)
+}
@@ -1,10 +1,15 @@
 package ds
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -14,6 +19,10 @@ const (
 
 const (
 	invalidVertexRunes = "#\n:,"
+	blankRunes         = " \t\r"
+
+	// quoteOpeners are the runes after which a double quote opens a quoted name.
+	quoteOpeners = "#,:>"
 )
 
 /*
@@ -32,28 +41,118 @@ ErrInvalidSer represents an error that happened during the parsing of a serializ
 */
 type ErrInvalidSer struct {
 	Reason error
+
+	// Line and Col locate the error in the input, both starting at 1, or 0 if unknown.
+	Line int
+	Col  int
 }
 
 func (e ErrInvalidSer) Error() string {
-	return fmt.Sprintf("invalid serialization: %s", e.Reason.Error())
+	switch {
+
+	case e.Line == 0:
+		return fmt.Sprintf("invalid serialization: %s", e.Reason.Error())
+
+	case e.Col == 0:
+		return fmt.Sprintf("invalid serialization: line %d: %s", e.Line, e.Reason.Error())
+
+	default:
+		return fmt.Sprintf("invalid serialization: line %d, col %d: %s", e.Line, e.Col, e.Reason.Error())
+	}
 }
 
 func (e ErrInvalidSer) Unwrap() error {
 	return e.Reason
 }
 
+/*
+ErrInvalidSerList holds every error found during the parsing of a serialized graph,
+ordered by their position in the input, when errors are collected (see TextParser).
+*/
+type ErrInvalidSerList []ErrInvalidSer
+
+func (l ErrInvalidSerList) Error() string {
+	msgs := make([]string, len(l))
+
+	for i, e := range l {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+/*
+Is reports whether any of the errors in the list matches target (see errors.Is). Go 1.20 and later
+follow Unwrap() []error on their own, but earlier versions only look for Is and As methods.
+*/
+func (l ErrInvalidSerList) Is(target error) bool {
+	for _, e := range l {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error in the list that matches target, setting target to it (see errors.As).
+func (l ErrInvalidSerList) As(target any) bool {
+	for _, e := range l {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l ErrInvalidSerList) Unwrap() []error {
+	errs := make([]error, len(l))
+
+	for i, e := range l {
+		errs[i] = e
+	}
+
+	return errs
+}
+
 /*
 TextParser produces a new graph from text in the following grammar:
 
-	Graph = GraphType ["\n" AdjEntries]
+	Graph = GraphType ["\n" Entries]
 	GraphType = "graph" | "digraph"
-	AdjEntries = AdjEntry {"\n" AdjEntry}
+	Entries = Entry {"\n" Entry}
+	Entry = AdjEntry | EdgeEntry | VertexEntry
 	AdjEntry = Vertex "#" [EdgeList]
-	Vertex = all characters but "#", "\n", ":", ","
+	EdgeEntry = Vertex "->" Vertex [":" Weight]
+	VertexEntry = Vertex
+	Vertex = Name | QuotedName
+	Name = all characters but "#", "\n", ":", ","
+	QuotedName = a double-quoted Go string literal
 	EdgeList = Edge {"," Edge}
 	Edge = Vertex [":" Weight]
 	Weight = float
 
+Anything after "//" is a comment, ignored until the end of the line, and blank characters
+at both ends of a line are ignored. Quoted names can hold any character, using the escape
+sequences of Go string literals (e.g.: "a \"b\", c // d"), and blank characters around
+them are ignored. The same goes for unquoted names in edge and vertex entries, which
+cannot hold "->" either, while unquoted names in adjacency entries are kept as they are.
+
+Earlier versions of the format had no comments, and only ignored tabs at both ends of a line,
+so blanks and "//" could be part of names. Instead of reading such names differently, any line
+that earlier versions would read as an adjacency entry with different names is rejected: names
+like these need to be quoted (e.g.: a#"b // c" instead of a#b // c).
+
+A vertex entry declares a vertex, and an edge entry adds a single edge, declaring its
+vertices if needed, and for undirected graphs, it works just like listing the destination
+in the adjacency list of the source. Destinations in adjacency lists must be declared
+somewhere else in the input, either by an entry of their own, or by an edge entry.
+
+Vertices are added in order of their first appearance in the input, and edges are added
+in input order, regardless of whether they are listed in adjacency entries or edge entries,
+so parsing the same input always produces the same graph.
+
 Sample (Undirected):
 
 	graph
@@ -67,6 +166,12 @@ Sample (Undirected):
 	h#a:8,b:11,g:1,i:7
 	i#c:2,g:6,h:7
 
+Every undirected edge is listed in the adjacency lists of both of its vertices. When parsing
+an undirected graph, the k-th occurrence of v in the list of u and the k-th occurrence of u
+in the list of v are the two linked copies of the same edge (see GE.Rev), and any edge
+listed only once gets its reverse copy added, at the end of the other adjacency list.
+Both copies must have the same weight, otherwise the last one in the input is reported.
+
 Sample (Directed)
 
 	digraph
@@ -76,153 +181,683 @@ Sample (Directed)
 	4#2
 	5#4
 	6#6
+
+Sample (Directed, using edge and vertex entries)
+
+	digraph
+	// services and their dependencies
+	"auth: v2" -> "db #1" : 2.5
+	"auth: v2" -> cache
+	cache -> "db #1"
+	"metrics, legacy"
 */
 type TextParser struct {
+	/*
+		LegacyUndirected makes the parser produce undirected graphs created using the
+		LegacyUndirected option, keeping every edge exactly as listed in the input, which
+		accepts copies of an undirected edge with different weights, like earlier versions.
+	*/
+	LegacyUndirected bool
+
+	/*
+		CollectErrors makes the parser keep going after finding an invalid entry or edge,
+		so every error in the input is reported at once, as an ErrInvalidSerList.
+		No graph is produced if any errors are found.
+	*/
+	CollectErrors bool
+
+	/*
+		Strict makes the parser report every vertex or edge that cannot be added to the graph
+		as an error, such as an edge listed twice, or a loop in an undirected graph, which are
+		otherwise silently dropped. Copies of an undirected edge with different weights are
+		reported either way, at the copy that comes last in the input.
+	*/
+	Strict bool
+
 	vars    map[string]*Text
-	pending map[*Text]string
+	refs    map[string]*Text
+	pending []textPending
 	graph   *G
+	errs    []ErrInvalidSer
+
+	// locs locates every edge added to an undirected graph in the input,
+	// following the adjacency lists, so that conflicts can be reported
+	locs [][]textLoc
+
+	// line and lineNo are the line being parsed, and its number.
+	line   string
+	lineNo int
+
+	// names holds the names read from the line being parsed, if it holds an adjacency entry.
+	names []string
 }
 
-func (p *TextParser) parseGraphType(raw string) error {
-	switch raw {
+// textLoc is the location of an edge in the input.
+type textLoc struct {
+	line int
+	col  int
+}
+
+/*
+textPending is an edge, from either an adjacency list or an edge entry, which is only added
+after every entry has been parsed, so that vertices can be added in input order, and then
+every edge can be added in input order as well. Its destination might not be declared yet.
+*/
+type textPending struct {
+	src *Text
+	dst *Text
+	wt  float64
+	at  textLoc
+}
+
+// textField is a piece of a line, starting at the byte offset off.
+type textField struct {
+	s   string
+	off int
+}
+
+// textErr is an error found at the byte offset off of the line being parsed.
+type textErr struct {
+	off int
+	err error
+}
+
+func (e textErr) Error() string {
+	return e.err.Error()
+}
+
+// start calculates the offset of the first character of the field that is not blank.
+func (f textField) start() int {
+	return f.off + len(f.s) - len(strings.TrimLeft(f.s, blankRunes))
+}
+
+// errAt creates an error found at the start of the given field.
+func errAt(f textField, msg string) error {
+	return textErr{f.start(), errors.New(msg)}
+}
+
+// closingQuote finds the index of the double quote that closes the quoted name opened at index i, or -1.
+func closingQuote(s string, i int) int {
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+
+		case '\\':
+			j++
+
+		case '"':
+			return j
+		}
+	}
+
+	return -1
+}
+
+// split splits a field around every occurrence of sep that is not inside a quoted name.
+func split(f textField, sep string) ([]textField, error) {
+	res := []textField{}
+
+	s := f.s
+	start := 0
+	opener := true
+
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' && opener {
+			j := closingQuote(s, i)
+
+			if j == -1 {
+				return nil, textErr{f.off + i, errors.New("vertex: unterminated quote")}
+			}
+
+			i = j
+			opener = false
+
+			continue
+		}
+
+		if strings.HasPrefix(s[i:], sep) {
+			res = append(res, textField{s[start:i], f.off + start})
+
+			i += len(sep) - 1
+			start = i + 1
+			opener = true
+
+			continue
+		}
+
+		if !strings.ContainsRune(blankRunes, rune(s[i])) {
+			opener = strings.ContainsRune(quoteOpeners, rune(s[i]))
+		}
+	}
+
+	return append(res, textField{s[start:], f.off + start}), nil
+}
+
+// detach copies a string, so that it does not keep the line it was taken from in memory.
+func detach(s string) string {
+	b := strings.Builder{}
+	b.WriteString(s)
+
+	return b.String()
+}
+
+// loc locates the start of the given field of the line being parsed.
+func (p *TextParser) loc(f textField) textLoc {
+	return textLoc{p.lineNo, utf8.RuneCountInString(p.line[:f.start()]) + 1}
+}
+
+// failAt records an error found at the given location, after the line it was found in was parsed.
+func (p *TextParser) failAt(err error, at textLoc) {
+	p.errs = append(p.errs, ErrInvalidSer{Reason: err, Line: at.line, Col: at.col})
+}
+
+// fail records an error found while parsing the current line.
+func (p *TextParser) fail(err error) {
+	e := ErrInvalidSer{Reason: err, Line: p.lineNo}
+
+	if te, ok := err.(textErr); ok {
+		e.Reason = te.err
+		e.Col = utf8.RuneCountInString(p.line[:te.off]) + 1
+	}
+
+	p.errs = append(p.errs, e)
+}
+
+// stopped checks whether or not parsing should stop, due to the errors found so far.
+func (p *TextParser) stopped() bool {
+	return len(p.errs) != 0 && !p.CollectErrors
+}
+
+func (p *TextParser) parseGraphType(f textField) error {
+	switch f.s {
 
 	case undirectedGraphKey:
-		p.graph = NewGraph()
+		// edges are only linked after every list has
+		// been parsed, so that the reverse copy of an
+		// edge never changes the order of a list
+		p.graph = NewGraphWith(LegacyUndirected())
 
 	case directedGraphKey:
 		p.graph = NewDigraph()
 
 	default:
-		return errors.New("graph type: bad name")
+		return errAt(f, "graph type: bad name")
 	}
 
 	return nil
 }
 
-func (p *TextParser) parseVertex(raw string) (*Text, error) {
+/*
+parseName parses the name of a vertex, unquoting it if needed. Blank characters
+around unquoted names are only ignored if trim is set.
+*/
+func (p *TextParser) parseName(f textField, trim bool) (string, error) {
+	t := strings.Trim(f.s, blankRunes)
+
+	if strings.HasPrefix(t, `"`) {
+		name, err := strconv.Unquote(t)
+
+		if err != nil {
+			return "", errAt(f, "vertex: bad quoted name")
+		}
+
+		if len(name) == 0 {
+			return "", errAt(f, "vertex: empty name")
+		}
+
+		return name, nil
+	}
+
+	raw := f.s
+
+	if trim {
+		raw = t
+	}
+
 	if len(raw) == 0 {
-		return nil, errors.New("vertex: empty name")
+		return "", errAt(f, "vertex: empty name")
 	}
 
 	if strings.ContainsAny(raw, invalidVertexRunes) {
-		return nil, errors.New("vertex: bad name")
+		return "", errAt(f, "vertex: bad name")
 	}
 
-	var res *Text
+	return raw, nil
+}
 
-	if v, ok := p.vars[raw]; ok {
-		res = v
-	} else {
-		v := Text(raw)
-		p.vars[raw] = &v
-		res = &v
+// ref finds the vertex with the given name, which might only be declared later in the input.
+func (p *TextParser) ref(name string) *Text {
+	if v, ok := p.vars[name]; ok {
+		return v
+	}
 
-		p.graph.AddVertex(res)
+	if v, ok := p.refs[name]; ok {
+		return v
 	}
 
-	return res, nil
+	v := Text(detach(name))
+	p.refs[string(v)] = &v
+
+	return &v
 }
 
-func (p *TextParser) parseEdge(src *Text, raw string) error {
-	if len(raw) == 0 {
-		return errors.New("edge: empty")
+// parseVertex parses the name of a vertex, declaring the vertex if this is its first appearance.
+func (p *TextParser) parseVertex(f textField, trim bool) (*Text, error) {
+	name, err := p.parseName(f, trim)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := p.vars[name]; ok {
+		return v, nil
+	}
+
+	v := p.ref(name)
+
+	delete(p.refs, name)
+	p.vars[string(*v)] = v
+
+	if _, err := p.graph.AddVertex(v); err != nil && p.Strict {
+		return nil, textErr{f.start(), fmt.Errorf("vertex: %q: %w", name, err)}
+	}
+
+	return v, nil
+}
+
+func (p *TextParser) parseWeight(f textField) (float64, error) {
+	wt, err := strconv.ParseFloat(strings.Trim(f.s, blankRunes), 64)
+
+	if err != nil {
+		return 0, textErr{f.start(), fmt.Errorf("weight: bad value %w", err)}
+	}
+
+	return wt, nil
+}
+
+// parseEdge parses an edge of an adjacency list, which is only added once every entry has been parsed.
+func (p *TextParser) parseEdge(src *Text, f textField) error {
+	if len(f.s) == 0 {
+		return errAt(f, "edge: empty")
 	}
 
 	var wt float64
 
-	edge := strings.Split(raw, ":")
+	edge, err := split(f, ":")
+
+	if err != nil {
+		return err
+	}
 
 	if len(edge) < 1 || len(edge) > 2 {
-		return errors.New("edge: wrong item count")
+		return errAt(f, "edge: wrong item count")
 	}
 
-	dst, ok := p.vars[edge[0]]
+	name, err := p.parseName(edge[0], false)
 
-	if !ok {
-		return errors.New("edge: unknown destination")
+	if err != nil {
+		return err
 	}
 
 	if len(edge) == 2 {
-		pWt, err := strconv.ParseFloat(edge[1], 64)
+		if wt, err = p.parseWeight(edge[1]); err != nil {
+			return err
+		}
+	}
 
-		if err != nil {
-			return fmt.Errorf("weight: bad value %w", err)
+	p.names = append(p.names, name)
+	p.pending = append(p.pending, textPending{src, p.ref(name), wt, p.loc(f)})
+
+	return nil
+}
+
+// addEdge adds an edge found at the given location, reporting any failures if the parser is strict.
+func (p *TextParser) addEdge(e textPending) error {
+	if _, ok := p.graph.VertexIndex(e.dst); !ok {
+		return errors.New("edge: unknown destination")
+	}
+
+	if _, _, err := p.graph.AddEdge(e.src, e.dst, e.wt); err != nil {
+		if p.Strict {
+			return fmt.Errorf("edge: %q -> %q: %w", e.src.Label(), e.dst.Label(), err)
 		}
 
-		wt = pWt
+		return nil
 	}
 
-	p.graph.AddEdge(src, dst, wt)
+	if p.graph.Undirected() && !p.LegacyUndirected {
+		v, _ := p.graph.VertexIndex(e.src)
+		p.locs[v] = append(p.locs[v], e.at)
+	}
 
 	return nil
 }
 
-func (p *TextParser) parseEdgeList(src *Text, raw string) error {
-	if len(raw) == 0 {
-		return nil
+// conflictErr locates the copy of an edge that conflicts with an earlier copy in the input, as found by symmetrize.
+func (p *TextParser) conflictErr(c wtConflict) ErrInvalidSer {
+	v, e := c.v, c.e
+	at := p.locs[v][e]
+
+	if other := p.locs[c.u][c.r]; other.line > at.line || (other.line == at.line && other.col > at.col) {
+		v, e, at = c.u, c.r, other
+	}
+
+	edge := p.graph.V[v].E[e]
+
+	return ErrInvalidSer{
+		Reason: fmt.Errorf("edge: %q -> %q: %w", p.graph.Item(edge.Src).Label(), p.graph.Item(edge.Dst).Label(), c),
+		Line:   at.line,
+		Col:    at.col,
+	}
+}
+
+// parseEdgeList parses the edges of an adjacency list, recording any errors found.
+func (p *TextParser) parseEdgeList(src *Text, f textField) {
+	if len(f.s) == 0 {
+		return
+	}
+
+	es, err := split(f, ",")
+
+	if err != nil {
+		p.fail(err)
+		return
 	}
 
-	for _, e := range strings.Split(raw, ",") {
+	for _, e := range es {
 		if err := p.parseEdge(src, e); err != nil {
-			return err
+			p.fail(err)
 		}
+
+		if p.stopped() {
+			return
+		}
+	}
+}
+
+func (p *TextParser) parseAdjEntry(adj []textField) error {
+	if len(adj) != 2 {
+		return errAt(adj[0], "adjacency list: wrong item count")
+	}
+
+	src, err := p.parseVertex(adj[0], false)
+
+	if err != nil {
+		return err
 	}
 
+	p.names = append(p.names, string(*src))
+	p.parseEdgeList(src, adj[1])
+
 	return nil
 }
 
-func (p *TextParser) parseAdjEntry(raw string) error {
-	adj := strings.Split(raw, "#")
+func (p *TextParser) parseEdgeEntry(ends []textField) error {
+	if len(ends) != 2 {
+		return errAt(ends[0], "edge: wrong item count")
+	}
 
-	if len(adj) != 2 {
-		return errors.New("adjacency list: wrong item count")
+	dstWt, err := split(ends[1], ":")
+
+	if err != nil {
+		return err
 	}
 
-	src, err := p.parseVertex(adj[0])
+	if len(dstWt) > 2 {
+		return errAt(ends[0], "edge: wrong item count")
+	}
+
+	src, err := p.parseVertex(ends[0], true)
 
 	if err != nil {
 		return err
 	}
 
-	// postponing the processing of adjacency lists
-	// so that vertices can be added in input order
-	p.pending[src] = adj[1]
+	dst, err := p.parseVertex(dstWt[0], true)
+
+	if err != nil {
+		return err
+	}
+
+	var wt float64
+
+	if len(dstWt) == 2 {
+		if wt, err = p.parseWeight(dstWt[1]); err != nil {
+			return err
+		}
+	}
+
+	// edges are added along with adjacency lists,
+	// so that they are added in input order
+	p.pending = append(p.pending, textPending{src, dst, wt, p.loc(ends[0])})
 
 	return nil
 }
 
-// Parse parses the input string, generating a new graph.
-func (p *TextParser) Parse(s string) (*G, func(string) int, error) {
+func (p *TextParser) parseEntry(f textField) error {
+	adj, err := split(f, "#")
+
+	if err != nil {
+		return err
+	}
+
+	if len(adj) > 1 {
+		return p.parseAdjEntry(adj)
+	}
+
+	ends, err := split(f, "->")
+
+	if err != nil {
+		return err
+	}
+
+	if len(ends) > 1 {
+		return p.parseEdgeEntry(ends)
+	}
+
+	_, err = p.parseVertex(f, true)
+
+	return err
+}
+
+// parseLine parses a line of the input, which might be the graph type, an entry, or just blanks and comments.
+func (p *TextParser) parseLine() error {
+	t := strings.TrimLeft(p.line, blankRunes)
+
+	// dropping comments
+	parts, err := split(textField{t, len(p.line) - len(t)}, "//")
+
+	if err != nil {
+		return err
+	}
+
+	f := parts[0]
+	f.s = strings.TrimRight(f.s, blankRunes)
+
+	if p.graph == nil {
+		if len(f.s) == 0 {
+			return nil
+		}
+
+		return p.parseGraphType(f)
+	}
+
+	p.names = nil
+	errs := len(p.errs)
+
+	if len(f.s) != 0 {
+		if err := p.parseEntry(f); err != nil || len(p.errs) != errs {
+			return err
+		}
+	}
+
+	return p.checkLegacy()
+}
+
+/*
+legacyNames reads a line like earlier versions of the parser did, before blanks and comments around entries were
+ignored, returning the names in the adjacency entry it holds, or false if earlier versions would have rejected it.
+*/
+func legacyNames(line string) ([]string, bool) {
+	// a line break is never part of a name, even if it is "\r\n"
+	l := strings.Trim(strings.TrimSuffix(line, "\r"), "\n\t")
+
+	adj := strings.Split(l, "#")
+
+	if len(adj) != 2 || len(adj[0]) == 0 || strings.ContainsAny(adj[0], invalidVertexRunes) {
+		return nil, false
+	}
+
+	names := []string{adj[0]}
+
+	if len(adj[1]) == 0 {
+		return names, true
+	}
+
+	for _, e := range strings.Split(adj[1], ",") {
+		edge := strings.Split(e, ":")
+
+		if len(edge) > 2 || len(edge[0]) == 0 {
+			return nil, false
+		}
+
+		if len(edge) == 2 {
+			if _, err := strconv.ParseFloat(edge[1], 64); err != nil {
+				return nil, false
+			}
+		}
+
+		names = append(names, edge[0])
+	}
+
+	return names, true
+}
+
+/*
+checkLegacy rejects a line that earlier versions of the parser would read as an adjacency entry, but with
+different names, due to blanks or comments around its unquoted names, instead of silently renaming them.
+*/
+func (p *TextParser) checkLegacy() error {
+	// earlier versions could not read quoted names
+	if strings.Contains(p.line, `"`) {
+		return nil
+	}
+
+	names, ok := legacyNames(p.line)
+
+	if !ok {
+		return nil
+	}
+
+	same := len(names) == len(p.names)
+
+	for i := 0; same && i < len(names); i++ {
+		same = names[i] == p.names[i]
+	}
+
+	if same {
+		return nil
+	}
+
+	return errAt(textField{p.line, 0}, "entry: ambiguous blanks or comment around unquoted names")
+}
+
+/*
+ParseReader parses the input read from r, line by line, generating a new graph.
+Errors found in the input are reported as an ErrInvalidSer, locating them in the input,
+or as an ErrInvalidSerList, if they are being collected, while any errors found while
+reading from r are returned as they are. An input without a graph type (e.g.: empty, or
+holding only blanks and comments) holds no graph, so neither a graph nor an error is returned.
+
+Lines are not kept after being parsed, but edges can only be added once every vertex is known,
+so every edge is kept until the end of the input, along with its location: memory use grows
+with the number of vertices and edges in the input, and with the length of their names,
+but not with the length of the lines, or with any blanks and comments around entries.
+*/
+func (p *TextParser) ParseReader(r io.Reader) (*G, func(string) int, error) {
 	p.vars = map[string]*Text{}
-	p.pending = map[*Text]string{}
+	p.refs = map[string]*Text{}
+	p.pending = nil
 	p.graph = nil
+	p.errs = nil
+	p.locs = nil
+	p.lineNo = 0
 
-	for _, l := range strings.Split(s, "\n") {
-		l = strings.Trim(l, "\n\t")
+	br := bufio.NewReader(r)
 
-		if len(l) == 0 {
+	for eof := false; !eof && !p.stopped(); {
+		l, err := br.ReadString('\n')
+
+		if err != nil && err != io.EOF {
+			return nil, nil, err
+		}
+
+		eof = err == io.EOF
+
+		if eof && len(l) == 0 {
+			break
+		}
+
+		p.line = strings.TrimSuffix(l, "\n")
+		p.lineNo++
+
+		if err = p.parseLine(); err == nil {
 			continue
 		}
 
+		p.fail(err)
+
+		// nothing else can be parsed without the graph type
 		if p.graph == nil {
-			if err := p.parseGraphType(l); err != nil {
-				return nil, nil, ErrInvalidSer{err}
-			}
+			break
+		}
+	}
 
-			continue
+	// an input without entries holds no graph, which is not an error
+	if p.graph == nil && len(p.errs) == 0 {
+		return nil, nil, nil
+	}
+
+	if p.graph != nil {
+		p.locs = make([][]textLoc, p.graph.VertexCount())
+	}
+
+	for _, e := range p.pending {
+		if p.stopped() {
+			break
 		}
 
-		if err := p.parseAdjEntry(l); err != nil {
-			return nil, nil, ErrInvalidSer{err}
+		if err := p.addEdge(e); err != nil {
+			p.failAt(err, e.at)
 		}
 	}
 
-	for src, raw := range p.pending {
-		if err := p.parseEdgeList(src, raw); err != nil {
-			return nil, nil, ErrInvalidSer{err}
+	p.pending = nil
+	p.refs = nil
+
+	if len(p.errs) == 0 && !p.LegacyUndirected {
+		var c wtConflict
+
+		if err := p.graph.symmetrize(); errors.As(err, &c) {
+			p.errs = append(p.errs, p.conflictErr(c))
 		}
 	}
 
+	if len(p.errs) != 0 {
+		if !p.CollectErrors {
+			return nil, nil, p.errs[0]
+		}
+
+		sort.SliceStable(p.errs, func(i, j int) bool {
+			if p.errs[i].Line != p.errs[j].Line {
+				return p.errs[i].Line < p.errs[j].Line
+			}
+
+			return p.errs[i].Col < p.errs[j].Col
+		})
+
+		return nil, nil, ErrInvalidSerList(p.errs)
+	}
+
 	idx := func(s string) int {
 		i, _ := p.graph.VertexIndex(p.vars[s])
 		return i
@@ -231,7 +866,131 @@ func (p *TextParser) Parse(s string) (*G, func(string) int, error) {
 	return p.graph, idx, nil
 }
 
+// Parse parses the input string, generating a new graph (see ParseReader).
+func (p *TextParser) Parse(s string) (*G, func(string) int, error) {
+	return p.ParseReader(strings.NewReader(s))
+}
+
 // Parse is a shorthand for creating a new TextParser and then using it to parse the input.
 func Parse(s string) (*G, func(string) int, error) {
 	return (&TextParser{}).Parse(s)
 }
+
+// ParseReader is a shorthand for creating a new TextParser and then using it to parse the input read from r.
+func ParseReader(r io.Reader) (*G, func(string) int, error) {
+	return (&TextParser{}).ParseReader(r)
+}
+
+/*
+TextSerializer writes a graph in the grammar accepted by TextParser, using adjacency entries:
+every vertex gets an entry, in order, listing the edges that leave it, also in order, with
+their weights only being written when they are not zero. Labels are quoted when needed.
+
+Parsing the output produces a graph with the same vertices and edges, in the same order,
+as long as every label is unique and not empty, and there are no parallel edges, which
+TextParser does not support. Labels that are empty or repeated are rejected, and so are
+parallel edges, even if the graph is a multigraph, before anything is written.
+*/
+type TextSerializer struct{}
+
+// needsQuote checks whether or not a label needs to be quoted to be parsed back as it is.
+func needsQuote(label string) bool {
+	if strings.ContainsAny(label, invalidVertexRunes+`"`) || strings.Contains(label, "//") {
+		return true
+	}
+
+	if strings.Trim(label, blankRunes) != label {
+		return true
+	}
+
+	for _, r := range label {
+		if !unicode.IsPrint(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// name writes the name of a vertex, given its label, quoting it if needed.
+func (s *TextSerializer) name(label string) string {
+	if needsQuote(label) {
+		return strconv.Quote(label)
+	}
+
+	return label
+}
+
+// Serialize writes the graph to w, returning any errors found while doing so.
+func (s *TextSerializer) Serialize(g Graph, w io.Writer) error {
+	seen := map[string]bool{}
+
+	for v := 0; v < g.VertexCount(); v++ {
+		label := g.Item(v).Label()
+
+		if len(label) == 0 {
+			return ErrInvLabel
+		}
+
+		if seen[label] {
+			return ErrDupLabel
+		}
+
+		seen[label] = true
+	}
+
+	// mark flags the destinations of the edges leaving a vertex,
+	// with a different stamp being used for each vertex
+	mark := make([]int, g.VertexCount())
+	dup := false
+
+	for v := 0; v < g.VertexCount() && !dup; v++ {
+		g.ForEachEdge(v, func(e GE) {
+			if mark[e.Dst] == v+1 {
+				dup = true
+			}
+
+			mark[e.Dst] = v + 1
+		})
+	}
+
+	if dup {
+		return ErrDupEdge
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if g.Directed() {
+		bw.WriteString(directedGraphKey)
+	} else {
+		bw.WriteString(undirectedGraphKey)
+	}
+
+	bw.WriteString("\n")
+
+	for v := 0; v < g.VertexCount(); v++ {
+		es := []string{}
+
+		g.ForEachEdge(v, func(e GE) {
+			edge := s.name(g.Item(e.Dst).Label())
+
+			if e.Wt != 0 {
+				edge += ":" + strconv.FormatFloat(e.Wt, 'g', -1, 64)
+			}
+
+			es = append(es, edge)
+		})
+
+		bw.WriteString(s.name(g.Item(v).Label()))
+		bw.WriteString("#")
+		bw.WriteString(strings.Join(es, ","))
+		bw.WriteString("\n")
+	}
+
+	return bw.Flush()
+}
+
+// Serialize is a shorthand for creating a new TextSerializer and then using it to write the graph to w.
+func Serialize(g Graph, w io.Writer) error {
+	return (&TextSerializer{}).Serialize(g, w)
+}
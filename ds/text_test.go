@@ -13,6 +13,189 @@ func TestTextLabel(t *testing.T) {
 	ut.Equal(t, "test", Text("test").Label())
 }
 
+func TestTextParser_links(t *testing.T) {
+	g, idx, err := Parse(`
+	graph
+	a#c,b
+	b#c
+	c#b,a
+	`)
+
+	ut.Nil(t, err)
+
+	// edges listed once get their reverse copy added
+	ut.Equal(t, 3, g.EdgeCount())
+	checkLinks(t, g)
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			ut.True(t, e.ID == g.V[e.Dst].E[e.Rev].ID)
+
+			_, _, ok := g.EdgeByID(e.ID)
+			ut.True(t, ok)
+		}
+	}
+
+	// lists keep the order of the input
+	ut.Equal(t, idx("c"), g.V[idx("a")].E[0].Dst)
+	ut.Equal(t, idx("b"), g.V[idx("a")].E[1].Dst)
+	ut.Equal(t, idx("c"), g.V[idx("b")].E[0].Dst)
+	ut.Equal(t, idx("a"), g.V[idx("b")].E[1].Dst)
+}
+
+func TestTextParser_legacy(t *testing.T) {
+	p := TextParser{LegacyUndirected: true}
+
+	g, _, err := p.Parse(`
+	graph
+	a#b
+	b#
+	`)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, g.EdgeCount())
+	ut.Equal(t, 0, len(g.V[1].E))
+}
+
+func TestTextParser_conflict(t *testing.T) {
+	_, _, err := Parse("graph\na#b:1\nb#a:2\n")
+
+	var e ErrInvalidSer
+
+	ut.True(t, errors.As(err, &e))
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	// the copy that comes last is reported
+	ut.Equal(t, 3, e.Line)
+	ut.Equal(t, 3, e.Col)
+
+	ut.True(t, strings.Contains(err.Error(), `edge: "b" -> "a": conflicting weights`))
+
+	// copies are paired by their order in the lists
+	_, _, err = Parse("graph\na#b:1,b:2\nb#a:1,a:2\n")
+	ut.Nil(t, err)
+
+	_, _, err = Parse("graph\na#b:1,b:2\nb#a:2,a:1\n")
+	ut.True(t, errors.Is(err, ErrWtConflict))
+
+	// kept as listed, without any links
+	_, _, err = (&TextParser{LegacyUndirected: true}).Parse("graph\na#b:1\nb#a:2\n")
+	ut.Nil(t, err)
+}
+
+func TestTextParser_entries(t *testing.T) {
+	g, idx, err := Parse(`
+	// header comments are fine
+	digraph // so are trailing ones
+
+	"auth: v2" -> "db #1" : 2.5
+	"auth: v2" -> cache
+	cache->"db #1"// no blanks needed
+	"metrics, \"legacy\""
+	"new\nline"#cache:1,"auth: v2"
+	`)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 5, g.VertexCount())
+	ut.Equal(t, 5, g.EdgeCount())
+
+	// vertices are declared in order of appearance
+	ut.Equal(t, "auth: v2", g.V[0].Label())
+	ut.Equal(t, "db #1", g.V[1].Label())
+	ut.Equal(t, "cache", g.V[2].Label())
+	ut.Equal(t, `metrics, "legacy"`, g.V[3].Label())
+	ut.Equal(t, "new\nline", g.V[4].Label())
+
+	src, e, ok := g.EdgeIndex(g.V[idx("auth: v2")].Item, g.V[idx("db #1")].Item)
+	ut.True(t, ok)
+	ut.Equal(t, 2.5, g.V[src].E[e].Wt)
+
+	_, _, ok = g.EdgeIndex(g.V[idx("cache")].Item, g.V[idx("db #1")].Item)
+	ut.True(t, ok)
+
+	ut.Equal(t, 0, len(g.V[idx(`metrics, "legacy"`)].E))
+	ut.Equal(t, 2, len(g.V[idx("new\nline")].E))
+}
+
+func TestTextParser_entries_undirected(t *testing.T) {
+	g, idx, err := Parse(`
+	graph
+	a#b:3
+	b -> a : 3
+	b -> c
+	d
+	`)
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 4, g.VertexCount())
+
+	// the edge entry is the other copy of the listed edge
+	ut.Equal(t, 2, g.EdgeCount())
+	checkLinks(t, g)
+
+	ut.Equal(t, 0, len(g.V[idx("d")].E))
+}
+
+func TestTextParser_entries_invalid(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		err   string
+	}{
+		{
+			desc:  "unterminated quote",
+			input: `"a -> b`,
+			err:   "vertex: unterminated quote",
+		},
+		{
+			desc:  "bad escape",
+			input: `"a\q" -> b`,
+			err:   "vertex: bad quoted name",
+		},
+		{
+			desc:  "empty quoted name",
+			input: `"" -> b`,
+			err:   "vertex: empty name",
+		},
+		{
+			desc:  "empty destination",
+			input: `a -> : 1`,
+			err:   "vertex: empty name",
+		},
+		{
+			desc:  "chained edges",
+			input: `a -> b -> c`,
+			err:   "edge: wrong item count",
+		},
+		{
+			desc:  "extra weight",
+			input: `a -> b : 1 : 2`,
+			err:   "edge: wrong item count",
+		},
+		{
+			desc:  "bad weight",
+			input: `a -> b : x`,
+			err:   "weight: bad value",
+		},
+		{
+			desc:  "bad vertex name",
+			input: `a:b`,
+			err:   "vertex: bad name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := Parse("digraph\n" + tc.input)
+
+			ut.True(t, errors.As(err, new(ErrInvalidSer)))
+			ut.True(t, strings.Contains(err.Error(), tc.err))
+		})
+	}
+}
+
 func TestTextParser(t *testing.T) {
 	cases := []struct {
 		desc      string
@@ -177,8 +360,538 @@ func TestTextParser(t *testing.T) {
 					ut.Equal(t, expectVerts[i], g.V[i].Label())
 				}
 
-				ut.Equal(t, tc.edgeCount, g.EdgeCount())
+				// every undirected edge is listed twice
+				if gType == undirectedGraphKey {
+					ut.Equal(t, tc.edgeCount/2, g.EdgeCount())
+				} else {
+					ut.Equal(t, tc.edgeCount, g.EdgeCount())
+				}
 			})
 		}
 	}
 }
+
+type failingWriter struct{}
+
+func (f failingWriter) Write(p []byte) (int, error) { return 0, errors.New("failed") }
+
+// checkRoundTrip checks that a serialized graph is parsed back into an identical graph.
+func checkRoundTrip(t *testing.T, g *G) {
+	b := strings.Builder{}
+
+	ut.Nil(t, Serialize(g, &b))
+
+	res, _, err := Parse(b.String())
+
+	ut.Nil(t, err)
+	ut.Equal(t, g.String(), res.String())
+
+	for v := range g.V {
+		ut.Equal(t, g.V[v].Label(), res.V[v].Label())
+
+		for e := range g.V[v].E {
+			ut.Equal(t, g.V[v].E[e].Wt, res.V[v].E[e].Wt)
+		}
+	}
+}
+
+func TestTextSerializer(t *testing.T) {
+	g := NewDigraph()
+
+	addVerts(t, g, vA, vB, vC)
+	addEdges(t, g, []edge{
+		{vA, vB, 1.5},
+		{vA, vC, 0},
+		{vC, vA, -2},
+	}...)
+
+	b := strings.Builder{}
+
+	ut.Nil(t, Serialize(g, &b))
+	ut.Equal(t, "digraph\na#b:1.5,c\nb#\nc#a:-2\n", b.String())
+
+	checkRoundTrip(t, g)
+}
+
+func TestTextSerializer_fixtures(t *testing.T) {
+	for _, src := range []string{
+		ut.UDGSimple,
+		ut.UDGDeps,
+		ut.UUGSimple,
+		ut.UUGDisc,
+		ut.WUGSimple,
+	} {
+		g, _, err := Parse(src)
+
+		ut.Nil(t, err)
+
+		checkRoundTrip(t, g)
+	}
+}
+
+func TestTextSerializer_labels(t *testing.T) {
+	labels := []Text{
+		"a#b",
+		"c,d",
+		"e:f",
+		"new\nline",
+		" padded ",
+		"tab\t",
+		"x // y",
+		`"quoted"`,
+		`mid"quote`,
+		"a->b",
+		"ünïcode",
+		"bell\a",
+	}
+
+	for gType, gen := range graphGen {
+		t.Run(gType, func(t *testing.T) {
+			g := gen()
+
+			for i := range labels {
+				addVerts(t, g, &labels[i])
+			}
+
+			for i := range labels {
+				addEdges(t, g, edge{&labels[i], &labels[(i+1)%len(labels)], float64(i) / 3})
+			}
+
+			checkRoundTrip(t, g)
+		})
+	}
+}
+
+func TestTextSerializer_invalid(t *testing.T) {
+	empty, dup := Text(""), Text("a")
+
+	g := NewGraph()
+
+	addVerts(t, g, vA, &empty)
+
+	err := Serialize(g, ut.DummyWriter{})
+	ut.True(t, errors.Is(err, ErrInvLabel))
+
+	g = NewGraph()
+
+	addVerts(t, g, vA, &dup)
+
+	err = Serialize(g, ut.DummyWriter{})
+	ut.True(t, errors.Is(err, ErrDupLabel))
+
+	g = NewGraph()
+
+	addVerts(t, g, vA)
+
+	err = Serialize(g, failingWriter{})
+	ut.NotNil(t, err)
+}
+
+func TestTextSerializer_multigraph(t *testing.T) {
+	for gType, gen := range map[string]func(...GraphOpt) *G{
+		"directed":   NewDigraphWith,
+		"undirected": NewGraphWith,
+	} {
+		t.Run(gType, func(t *testing.T) {
+			g := gen(Multigraph())
+
+			addVerts(t, g, vA, vB, vC)
+			addEdges(t, g, []edge{
+				{vA, vB, 1},
+				{vB, vC, 2},
+			}...)
+
+			// no parallel edges yet
+			checkRoundTrip(t, g)
+
+			addEdges(t, g, edge{vA, vB, 2})
+
+			b := strings.Builder{}
+
+			err := Serialize(g, &b)
+
+			ut.True(t, errors.Is(err, ErrDupEdge))
+			ut.True(t, errors.Is(err, ErrExists))
+			ut.Equal(t, 0, b.Len())
+		})
+	}
+}
+
+type failingReader struct{}
+
+func (f failingReader) Read(p []byte) (int, error) { return 0, errors.New("failed") }
+
+func TestTextParser_positions(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		line  int
+		col   int
+		err   string
+	}{
+		{
+			desc:  "bad weight",
+			input: "digraph\na#b\n  b#c:x\nc#",
+			line:  3,
+			col:   7,
+			err:   "weight: bad value",
+		},
+		{
+			desc:  "runes",
+			input: "digraph\nü#ü:x",
+			line:  2,
+			col:   5,
+			err:   "weight: bad value",
+		},
+		{
+			desc:  "unknown destination",
+			input: "graph\na#b\nb#a,\tx",
+			line:  3,
+			col:   6,
+			err:   "edge: unknown destination",
+		},
+		{
+			desc:  "unterminated quote",
+			input: "graph\n\na -> \"b",
+			line:  3,
+			col:   6,
+			err:   "vertex: unterminated quote",
+		},
+		{
+			desc:  "bad graph type",
+			input: "// header\n  tree",
+			line:  2,
+			col:   3,
+			err:   "graph type: bad name",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := ParseReader(strings.NewReader(tc.input))
+
+			var e ErrInvalidSer
+
+			ut.True(t, errors.As(err, &e))
+			ut.Equal(t, tc.line, e.Line)
+			ut.Equal(t, tc.col, e.Col)
+
+			ut.True(t, strings.Contains(err.Error(), fmt.Sprintf("line %d, col %d: %s", tc.line, tc.col, tc.err)))
+		})
+	}
+}
+
+func TestTextParser_ambiguous(t *testing.T) {
+	cases := []struct {
+		desc  string
+		input string
+		line  int
+		col   int
+	}{
+		{
+			desc:  "leading spaces",
+			input: "digraph\n  a#b\nb#",
+			line:  2,
+			col:   3,
+		},
+		{
+			desc:  "trailing spaces",
+			input: "digraph\na#b  \nb#",
+			line:  2,
+			col:   1,
+		},
+		{
+			desc:  "trailing spaces after empty list",
+			input: "digraph\na# \nb#",
+			line:  2,
+			col:   1,
+		},
+		{
+			desc:  "comment after name",
+			input: "digraph\na#b // x\nb#",
+			line:  2,
+			col:   1,
+		},
+		{
+			desc:  "comment holding an entry",
+			input: "digraph\n// a#b\nb#",
+			line:  2,
+			col:   1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, _, err := Parse(tc.input)
+
+			var e ErrInvalidSer
+
+			ut.True(t, errors.As(err, &e))
+			ut.Equal(t, tc.line, e.Line)
+			ut.Equal(t, tc.col, e.Col)
+
+			ut.True(t, strings.Contains(err.Error(), "entry: ambiguous"))
+		})
+	}
+
+	// lines that earlier versions either read the same way, or rejected
+	for _, src := range []string{
+		"digraph\r\na#b\r\nb#\r\n",
+		"digraph\n\ta#b\t\n\tb#",
+		"digraph\n// header\na#b:1 // weight\nb#",
+		"digraph\na#\"b\" // quoted\nb#",
+		"digraph\na -> b // edge entry\n  c",
+	} {
+		_, _, err := Parse(src)
+		ut.Nil(t, err)
+	}
+}
+
+func TestTextParser_collect(t *testing.T) {
+	p := TextParser{CollectErrors: true}
+
+	g, _, err := p.Parse(`digraph
+a#b:x,c,y
+b#a:1:2
+"c
+d -> e : z
+`)
+
+	ut.True(t, g == nil)
+
+	var errs ErrInvalidSerList
+
+	ut.True(t, errors.As(err, &errs))
+	ut.Equal(t, 6, len(errs))
+
+	expect := []struct {
+		line int
+		col  int
+	}{
+		{2, 5},
+		{2, 7},
+		{2, 9},
+		{3, 3},
+		{4, 1},
+		{5, 10},
+	}
+
+	for i, e := range expect {
+		ut.Equal(t, e.line, errs[i].Line)
+		ut.Equal(t, e.col, errs[i].Col)
+	}
+
+	ut.Equal(t, 6, len(strings.Split(err.Error(), "\n")))
+
+	// nothing can be parsed without the graph type
+	_, _, err = p.Parse("tree\na#b\nb#c")
+
+	ut.True(t, errors.As(err, &errs))
+	ut.Equal(t, 1, len(errs))
+}
+
+func TestErrInvalidSerList(t *testing.T) {
+	p := TextParser{CollectErrors: true}
+
+	_, _, err := p.Parse("graph\na#b:1\nb#a:2")
+
+	var errs ErrInvalidSerList
+
+	ut.True(t, errors.As(err, &errs))
+	ut.Equal(t, 1, len(errs))
+
+	// calling the methods directly, since toolchains older
+	// than Go 1.20 do not follow Unwrap() []error on their own
+	ut.True(t, errs.Is(ErrWtConflict))
+	ut.False(t, errs.Is(ErrDupLabel))
+
+	var e ErrInvalidSer
+
+	ut.True(t, errs.As(&e))
+	ut.Equal(t, 3, e.Line)
+	ut.False(t, errs.As(new(ErrInvalidSerList)))
+}
+
+func TestTextParser_reader(t *testing.T) {
+	b := strings.Builder{}
+
+	b.WriteString("digraph\r\n")
+
+	for i := 0; i < 10000; i++ {
+		b.WriteString(fmt.Sprintf("v%d -> v%d : %d\r\n", i, i+1, i))
+	}
+
+	g, idx, err := ParseReader(strings.NewReader(b.String()))
+
+	ut.Nil(t, err)
+	ut.Equal(t, 10001, g.VertexCount())
+	ut.Equal(t, 10000, g.EdgeCount())
+	ut.Equal(t, 42.0, g.V[idx("v42")].E[0].Wt)
+
+	_, _, err = ParseReader(failingReader{})
+
+	ut.NotNil(t, err)
+	ut.False(t, errors.As(err, new(ErrInvalidSer)))
+
+	// inputs without a graph type hold no graph
+	for _, s := range []string{"", "\n\n", "\n// empty\n"} {
+		g, idx, err = ParseReader(strings.NewReader(s))
+
+		ut.Nil(t, err)
+		ut.True(t, g == nil)
+		ut.True(t, idx == nil)
+	}
+}
+
+func TestTextParser_order(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		g, idx, err := Parse(`
+		digraph
+		a#b
+		c#a,b
+		a -> c : 1
+		b#c
+		a#d
+		d
+		`)
+
+		ut.Nil(t, err)
+
+		// lists of the same vertex are merged, in input order
+		a := g.V[idx("a")]
+
+		ut.Equal(t, 3, len(a.E))
+		ut.Equal(t, idx("b"), a.E[0].Dst)
+		ut.Equal(t, idx("c"), a.E[1].Dst)
+		ut.Equal(t, idx("d"), a.E[2].Dst)
+
+		// edges are added in input order
+		slots := []int{
+			a.E[0].ID.slot,
+			g.V[idx("c")].E[0].ID.slot,
+			g.V[idx("c")].E[1].ID.slot,
+			a.E[1].ID.slot,
+			g.V[idx("b")].E[0].ID.slot,
+			a.E[2].ID.slot,
+		}
+
+		for j := 1; j < len(slots); j++ {
+			ut.True(t, slots[j-1] < slots[j])
+		}
+	}
+}
+
+func TestTextParser_strict(t *testing.T) {
+	cases := []struct {
+		desc   string
+		input  string
+		line   int
+		col    int
+		expect error
+	}{
+		{
+			desc:   "repeated edge",
+			input:  "digraph\na#b,b:2\nb#",
+			line:   2,
+			col:    5,
+			expect: ErrExists,
+		},
+		{
+			desc:   "repeated edge entry",
+			input:  "digraph\na#b\n  a -> b\nb#",
+			line:   3,
+			col:    3,
+			expect: ErrExists,
+		},
+		{
+			desc:   "undirected loop",
+			input:  "graph\na#b,a\nb#a",
+			line:   2,
+			col:    5,
+			expect: ErrInvLoop,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// silently dropped by default
+			g, _, err := Parse(tc.input)
+
+			ut.Nil(t, err)
+			ut.Equal(t, 1, g.EdgeCount())
+
+			p := TextParser{Strict: true}
+
+			_, _, err = p.Parse(tc.input)
+
+			var e ErrInvalidSer
+
+			ut.True(t, errors.As(err, &e))
+			ut.True(t, errors.Is(err, tc.expect))
+
+			ut.Equal(t, tc.line, e.Line)
+			ut.Equal(t, tc.col, e.Col)
+
+			ut.True(t, strings.Contains(err.Error(), `edge: "a" -> `))
+		})
+	}
+
+	conflicts := []struct {
+		desc  string
+		input string
+		line  int
+		col   int
+	}{
+		{
+			desc:  "adjacency lists",
+			input: "graph\na#b:1\nb#c,a:2\nc#b",
+			line:  3,
+			col:   5,
+		},
+		{
+			desc:  "edge entries",
+			input: "graph\na -> b : 1\n  b -> a : 2",
+			line:  3,
+			col:   3,
+		},
+	}
+
+	for _, tc := range conflicts {
+		t.Run(tc.desc, func(t *testing.T) {
+			p := TextParser{Strict: true}
+
+			_, _, err := p.Parse(tc.input)
+
+			var e ErrInvalidSer
+
+			ut.True(t, errors.As(err, &e))
+			ut.True(t, errors.Is(err, ErrWtConflict))
+
+			ut.Equal(t, tc.line, e.Line)
+			ut.Equal(t, tc.col, e.Col)
+
+			ut.True(t, strings.Contains(err.Error(), `edge: "b" -> "a": conflicting weights`))
+		})
+	}
+
+	p := TextParser{Strict: true, CollectErrors: true}
+
+	_, _, err := p.Parse("graph\na#a,b,b\nb#b")
+
+	var errs ErrInvalidSerList
+
+	ut.True(t, errors.As(err, &errs))
+	ut.Equal(t, 3, len(errs))
+
+	// well-formed inputs are not affected
+	for _, src := range []string{
+		ut.UDGSimple,
+		ut.UDGDeps,
+		ut.UUGSimple,
+		ut.UUGDisc,
+		ut.WUGSimple,
+	} {
+		_, _, err = (&TextParser{Strict: true}).Parse(src)
+		ut.Nil(t, err)
+	}
+}
@@ -4,6 +4,9 @@ package ds
 A GraphVisitor implements the Visitor pattern (https://en.wikipedia.org/wiki/Visitor_pattern) for gga graphs.
 A Visitor declares methods that are executed at specific points during the traversal of a data structure.
 This way, multiple behaviors can be attached to the data structure without having to modify it directly.
+
+A GraphVisitor can only visit a G (see G.Accept). Visitors that need to work on any graph
+implementation (e.g.: M, CSR) should implement the Visitor interface instead.
 */
 type GraphVisitor interface {
 	// VisitGraphStart is called at the beginning of the graph visit.
@@ -18,3 +21,41 @@ type GraphVisitor interface {
 	// VisitEdge is called when visiting a graph edge.
 	VisitEdge(g G, e GE)
 }
+
+/*
+A Visitor is like a GraphVisitor, but it can visit any Visitable graph, which it gets as a Graph.
+Its methods have names of their own, so the same type can implement both interfaces.
+*/
+type Visitor interface {
+	// VisitStart is called at the beginning of the graph visit.
+	VisitStart(g Graph)
+
+	// VisitEnd is called at the end of the graph visit.
+	VisitEnd(g Graph)
+
+	// VisitGV is called when visiting a graph vertex.
+	VisitGV(g Graph, v GV)
+
+	// VisitGE is called when visiting a graph edge.
+	VisitGE(g Graph, e GE)
+}
+
+// A Visitable graph can be walked by a Visitor, guiding its execution over every vertex and edge.
+type Visitable interface {
+	Walk(vis Visitor)
+}
+
+// walk guides the execution of a Visitor over every vertex and edge of a graph, in order.
+func walk(g Graph, vis Visitor, vertex func(int) GV) {
+	vis.VisitStart(g)
+
+	for v := 0; v < g.VertexCount(); v++ {
+		vis.VisitGV(g, vertex(v))
+
+		g.ForEachEdge(v, func(e GE) {
+			vis.VisitGE(g, e)
+		})
+	}
+
+	vis.VisitEnd(g)
+}
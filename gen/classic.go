@@ -0,0 +1,241 @@
+package gen
+
+import "github.com/vc-souza/gga/ds"
+
+/*
+Complete generates the complete graph K(n), with an edge between every pair of distinct vertices,
+which goes in both directions, if the graph is directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: Θ(V²)
+*/
+func Complete(n int, opts ...Opt) (*ds.G, error) {
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			b.both(u, v)
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+Path generates the path graph P(n), with an edge from every vertex i to the vertex i + 1.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func Path(n int, opts ...Opt) (*ds.G, error) {
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u+1 < n; u++ {
+		b.edge(u, u+1)
+	}
+
+	return b.g, nil
+}
+
+/*
+Cycle generates the cycle graph C(n), which needs at least 3 vertices: the path graph P(n)
+(see Path), with an extra edge from its last vertex back to its first one.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func Cycle(n int, opts ...Opt) (*ds.G, error) {
+	if n < 3 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < n; u++ {
+		b.edge(u, (u+1)%n)
+	}
+
+	return b.g, nil
+}
+
+/*
+Star generates the star graph S(n), which needs at least 1 vertex: the vertex 0 is its center,
+and there is an edge from the center to every other vertex.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func Star(n int, opts ...Opt) (*ds.G, error) {
+	if n < 1 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for v := 1; v < n; v++ {
+		b.edge(0, v)
+	}
+
+	return b.g, nil
+}
+
+/*
+Grid generates a grid graph with the given number of rows and columns, where the vertex at row r
+and column c has index r * cols + c, with an edge to the next vertex in its row, and another one
+to the next vertex in its column. If the graph is directed, every edge points right or down.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func Grid(rows, cols int, opts ...Opt) (*ds.G, error) {
+	return lattice(rows, cols, false, opts)
+}
+
+/*
+Torus generates a torus graph with the given number of rows and columns, which must be at least 3:
+the grid graph (see Grid) where the last vertex of every row and column is also connected to the
+first vertex of its row or column.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func Torus(rows, cols int, opts ...Opt) (*ds.G, error) {
+	if rows < 3 || cols < 3 {
+		return nil, ErrInvParam
+	}
+
+	return lattice(rows, cols, true, opts)
+}
+
+// lattice generates a grid graph, with its rows and columns wrapping around if wrap is set.
+func lattice(rows, cols int, wrap bool, opts []Opt) (*ds.G, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(rows*cols, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			u := r*cols + c
+
+			if c+1 < cols || wrap {
+				b.edge(u, r*cols+(c+1)%cols)
+			}
+
+			if r+1 < rows || wrap {
+				b.edge(u, (r+1)%rows*cols+c)
+			}
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+Hypercube generates the hypercube graph Q(d), with a vertex for every binary string of length d,
+and an edge between every pair of vertices whose strings differ in a single bit. If the graph is
+directed, every edge goes from the vertex with the lowest index to the one with the highest.
+
+Complexity:
+	- Time:  Θ(V log V)
+	- Space: Θ(V log V)
+*/
+func Hypercube(d int, opts ...Opt) (*ds.G, error) {
+	if d < 0 || d > 30 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(1<<d, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < 1<<d; u++ {
+		for k := 0; k < d; k++ {
+			if v := u ^ 1<<k; u < v {
+				b.edge(u, v)
+			}
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+CompleteBipartite generates the complete bipartite graph K(m, n), where the first m vertices
+are in one part, the last n vertices are in the other one, and there is an edge from every
+vertex of the first part to every vertex of the second one.
+
+Complexity:
+	- Time:  Θ(V + m * n)
+	- Space: Θ(V + m * n)
+*/
+func CompleteBipartite(m, n int, opts ...Opt) (*ds.G, error) {
+	if m < 0 || n < 0 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(m+n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < m; u++ {
+		for v := m; v < m+n; v++ {
+			b.edge(u, v)
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+BinaryTree generates a complete binary tree with n vertices, where the vertex 0 is the root,
+and every vertex i has an edge to each one of its children: the vertices 2i + 1 and 2i + 2.
+
+Complexity:
+	- Time:  Θ(V)
+	- Space: Θ(V)
+*/
+func BinaryTree(n int, opts ...Opt) (*ds.G, error) {
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for v := 1; v < n; v++ {
+		b.edge((v-1)/2, v)
+	}
+
+	return b.g, nil
+}
@@ -0,0 +1,203 @@
+package gen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// checkSimple checks that a generated graph has no loops, and no parallel edges.
+func checkSimple(t *testing.T, g *ds.G) {
+	for v := range g.V {
+		seen := map[int]bool{}
+
+		for _, e := range g.V[v].E {
+			ut.False(t, e.Dst == v)
+			ut.False(t, seen[e.Dst])
+
+			seen[e.Dst] = true
+		}
+	}
+}
+
+func TestClassic(t *testing.T) {
+	cases := []struct {
+		desc   string
+		gen    func(opts ...Opt) (*ds.G, error)
+		vCount int
+		eCount int
+		dCount int
+	}{
+		{
+			desc:   "complete",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Complete(5, opts...) },
+			vCount: 5,
+			eCount: 10,
+			dCount: 20,
+		},
+		{
+			desc:   "path",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Path(5, opts...) },
+			vCount: 5,
+			eCount: 4,
+			dCount: 4,
+		},
+		{
+			desc:   "cycle",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Cycle(5, opts...) },
+			vCount: 5,
+			eCount: 5,
+			dCount: 5,
+		},
+		{
+			desc:   "star",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Star(5, opts...) },
+			vCount: 5,
+			eCount: 4,
+			dCount: 4,
+		},
+		{
+			desc:   "grid",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Grid(3, 4, opts...) },
+			vCount: 12,
+			eCount: 17,
+			dCount: 17,
+		},
+		{
+			desc:   "torus",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Torus(3, 4, opts...) },
+			vCount: 12,
+			eCount: 24,
+			dCount: 24,
+		},
+		{
+			desc:   "hypercube",
+			gen:    func(opts ...Opt) (*ds.G, error) { return Hypercube(3, opts...) },
+			vCount: 8,
+			eCount: 12,
+			dCount: 12,
+		},
+		{
+			desc:   "complete bipartite",
+			gen:    func(opts ...Opt) (*ds.G, error) { return CompleteBipartite(2, 3, opts...) },
+			vCount: 5,
+			eCount: 6,
+			dCount: 6,
+		},
+		{
+			desc:   "binary tree",
+			gen:    func(opts ...Opt) (*ds.G, error) { return BinaryTree(6, opts...) },
+			vCount: 6,
+			eCount: 5,
+			dCount: 5,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g, err := tc.gen()
+
+			ut.Nil(t, err)
+			ut.True(t, g.Undirected())
+			ut.Equal(t, tc.vCount, g.VertexCount())
+			ut.Equal(t, tc.eCount, g.EdgeCount())
+
+			checkSimple(t, g)
+
+			for v := range g.V {
+				ut.True(t, g.V[v].Item == Vertex(v))
+			}
+
+			g, err = tc.gen(Directed())
+
+			ut.Nil(t, err)
+			ut.True(t, g.Directed())
+			ut.Equal(t, tc.vCount, g.VertexCount())
+			ut.Equal(t, tc.dCount, g.EdgeCount())
+
+			checkSimple(t, g)
+		})
+	}
+}
+
+func TestClassic_layout(t *testing.T) {
+	g, err := Grid(2, 3, Directed())
+
+	ut.Nil(t, err)
+
+	// the vertex at row 0, column 1 points right and down
+	ut.Equal(t, 2, len(g.V[1].E))
+	ut.Equal(t, 2, g.V[1].E[0].Dst)
+	ut.Equal(t, 4, g.V[1].E[1].Dst)
+
+	g, err = BinaryTree(7, Directed())
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 2, len(g.V[2].E))
+	ut.Equal(t, 5, g.V[2].E[0].Dst)
+	ut.Equal(t, 6, g.V[2].E[1].Dst)
+
+	g, err = Hypercube(3)
+
+	ut.Nil(t, err)
+
+	for v := range g.V {
+		ut.Equal(t, 3, len(g.V[v].E))
+	}
+}
+
+func TestClassic_weights(t *testing.T) {
+	g1, err := Complete(6, Weights(2, 5), Seed(7))
+	ut.Nil(t, err)
+
+	g2, err := Complete(6, Weights(2, 5), Seed(7))
+	ut.Nil(t, err)
+
+	ut.Equal(t, g1.String(), g2.String())
+
+	for v := range g1.V {
+		for _, e := range g1.V[v].E {
+			ut.True(t, e.Wt >= 2 && e.Wt < 5)
+		}
+	}
+
+	g3, err := Complete(6, Weights(2, 5), Seed(8))
+	ut.Nil(t, err)
+
+	ut.False(t, g1.String() == g3.String())
+
+	g4, err := Complete(6)
+	ut.Nil(t, err)
+
+	for v := range g4.V {
+		for _, e := range g4.V[v].E {
+			ut.Equal(t, 0.0, e.Wt)
+		}
+	}
+}
+
+func TestClassic_invalid(t *testing.T) {
+	cases := []struct {
+		desc string
+		gen  func() (*ds.G, error)
+	}{
+		{"negative size", func() (*ds.G, error) { return Path(-1) }},
+		{"short cycle", func() (*ds.G, error) { return Cycle(2) }},
+		{"empty star", func() (*ds.G, error) { return Star(0) }},
+		{"negative grid", func() (*ds.G, error) { return Grid(-1, 2) }},
+		{"thin torus", func() (*ds.G, error) { return Torus(2, 3) }},
+		{"negative hypercube", func() (*ds.G, error) { return Hypercube(-1) }},
+		{"negative bipartite", func() (*ds.G, error) { return CompleteBipartite(2, -1) }},
+		{"reversed weights", func() (*ds.G, error) { return Complete(3, Weights(2, 1)) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := tc.gen()
+			ut.True(t, errors.Is(err, ErrInvParam))
+		})
+	}
+}
@@ -0,0 +1,2 @@
+// Package gen implements generators of graphs, using the data structures from the 'ds' package, for testing and benchmarking. Every random choice is made by a seeded source, so generated graphs can be reproduced. Any assertions regarding asymptotic behavior assume the worst-case scenario.
+package gen
@@ -0,0 +1,132 @@
+package gen
+
+import (
+	"errors"
+	"math/rand"
+	"strconv"
+
+	"github.com/vc-souza/gga/ds"
+)
+
+var ErrInvParam = errors.New("invalid parameter")
+
+/*
+Vertex is the ds.Item implementation held by the vertices of generated graphs,
+with the i-th vertex of a generated graph holding Vertex(i), labeled after i.
+*/
+type Vertex int
+
+func (v Vertex) Label() string {
+	return strconv.Itoa(int(v))
+}
+
+// cfg holds the configuration of a generator.
+type cfg struct {
+	dir      bool
+	seed     int64
+	weighted bool
+	minWt    float64
+	maxWt    float64
+}
+
+// An Opt configures a generator.
+type Opt func(*cfg)
+
+// Directed makes a generator create a directed graph, instead of an undirected one.
+func Directed() Opt {
+	return func(c *cfg) {
+		c.dir = true
+	}
+}
+
+/*
+Seed sets the seed of the source of randomness used by a generator. Using the same seed,
+along with the same parameters, always generates the same graph. By default, the seed is 1.
+*/
+func Seed(s int64) Opt {
+	return func(c *cfg) {
+		c.seed = s
+	}
+}
+
+// Weights makes a generator assign random weights to edges, uniformly chosen from [lo, hi).
+func Weights(lo, hi float64) Opt {
+	return func(c *cfg) {
+		c.weighted = true
+		c.minWt = lo
+		c.maxWt = hi
+	}
+}
+
+// builder creates a generated graph, holding the source of randomness used while doing so.
+type builder struct {
+	cfg
+	rng *rand.Rand
+	g   *ds.G
+}
+
+// newBuilder creates a builder for a graph with n vertices, configured by the given options.
+func newBuilder(n int, opts []Opt) (*builder, error) {
+	b := &builder{}
+
+	b.seed = 1
+
+	for _, opt := range opts {
+		opt(&b.cfg)
+	}
+
+	if n < 0 || b.maxWt < b.minWt {
+		return nil, ErrInvParam
+	}
+
+	b.rng = rand.New(rand.NewSource(b.seed))
+
+	if b.dir {
+		b.g = ds.NewDigraph()
+	} else {
+		b.g = ds.NewGraph()
+	}
+
+	for i := 0; i < n; i++ {
+		b.g.AddVertex(Vertex(i))
+	}
+
+	return b, nil
+}
+
+// weight chooses a random weight from [lo, hi), if the generator assigns weights to edges.
+func (b *builder) weight(lo, hi float64) float64 {
+	if !b.weighted {
+		return 0
+	}
+
+	return lo + b.rng.Float64()*(hi-lo)
+}
+
+// edge adds an edge from the vertex at index u to the vertex at index v, weighted if needed.
+func (b *builder) edge(u, v int) {
+	b.edgeWt(u, v, b.weight(b.minWt, b.maxWt))
+}
+
+// edgeWt adds an edge from the vertex at index u to the vertex at index v, with the given weight.
+func (b *builder) edgeWt(u, v int, wt float64) {
+	b.g.AddEdge(b.g.V[u].Item, b.g.V[v].Item, wt)
+}
+
+// both adds an edge between the vertices at indexes u and v, in both directions if the graph is directed.
+func (b *builder) both(u, v int) {
+	b.edge(u, v)
+
+	if b.dir {
+		b.edge(v, u)
+	}
+}
+
+// maxEdges calculates the maximum number of edges of a graph with n vertices, without loops or parallel edges.
+func (b *builder) maxEdges(n int) int {
+	if b.dir {
+		return n * (n - 1)
+	}
+
+	return n * (n - 1) / 2
+}
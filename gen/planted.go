@@ -0,0 +1,427 @@
+package gen
+
+import (
+	"sort"
+
+	"github.com/vc-souza/gga/ds"
+)
+
+// wilson chooses a uniformly random spanning tree of the complete graph K(n), rooted at the vertex 0.
+func (b *builder) wilson(n int) []int {
+	parent := make([]int, n)
+	inTree := make([]bool, n)
+
+	parent[0] = -1
+	inTree[0] = true
+
+	for v := 1; v < n; v++ {
+		// random walk until the tree is reached, with parent
+		// recording the last exit from every visited vertex,
+		// which erases the loops made by the walk
+		for u := v; !inTree[u]; u = parent[u] {
+			w := b.rng.Intn(n - 1)
+
+			if w >= u {
+				w++
+			}
+
+			parent[u] = w
+		}
+
+		for u := v; !inTree[u]; u = parent[u] {
+			inTree[u] = true
+		}
+	}
+
+	return parent
+}
+
+// groups randomly assigns vertices to groups with the given sizes, with the vertices of each group in order.
+func (b *builder) groups(sizes []int) [][]int {
+	n := 0
+
+	for _, s := range sizes {
+		n += s
+	}
+
+	perm := b.rng.Perm(n)
+	res := make([][]int, len(sizes))
+
+	for i, s := range sizes {
+		res[i] = append([]int(nil), perm[:s]...)
+		perm = perm[s:]
+
+		sort.Ints(res[i])
+	}
+
+	return res
+}
+
+/*
+RandomTree generates a uniformly random labeled tree with n vertices, using Wilson's algorithm, returning it
+along with the parent of every vertex, with the vertex 0 as the root, and -1 as its parent. If the graph is
+directed, every edge goes from a parent to its child.
+
+Complexity:
+	- Time:  O(V²) expected
+	- Space: Θ(V)
+*/
+func RandomTree(n int, opts ...Opt) (*ds.G, []int, error) {
+	if n < 1 {
+		return nil, nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parent := b.wilson(n)
+
+	for v := 1; v < n; v++ {
+		b.edge(parent[v], v)
+	}
+
+	return b.g, parent, nil
+}
+
+/*
+Connected generates a random connected graph with n vertices and m edges, where n - 1 <= m, returning it
+along with the random spanning tree (see RandomTree) that was planted to connect it, as the parent of every
+vertex. If the graph is directed, every edge of the tree goes from a parent to its child, so every vertex
+is reachable from the vertex 0.
+
+If weights are assigned, edges of the tree weigh less than any other edge, with their weights chosen
+from the lower half of the range of weights, so the tree is a minimum spanning tree of the graph.
+
+Complexity:
+	- Time:  O(V² + E) expected
+	- Space: O(V² + E)
+*/
+func Connected(n, m int, opts ...Opt) (*ds.G, []int, error) {
+	if n < 1 {
+		return nil, nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if m < n-1 || m > b.maxEdges(n) {
+		return nil, nil, ErrInvParam
+	}
+
+	mid := b.minWt + (b.maxWt-b.minWt)/2
+
+	parent := b.wilson(n)
+
+	taken := map[[2]int]bool{}
+
+	for v := 1; v < n; v++ {
+		taken[[2]int{parent[v], v}] = true
+
+		if !b.dir {
+			taken[[2]int{v, parent[v]}] = true
+		}
+
+		b.edgeWt(parent[v], v, b.weight(b.minWt, mid))
+	}
+
+	extra := [][2]int{}
+
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u == v || (!b.dir && v < u) || taken[[2]int{u, v}] {
+				continue
+			}
+
+			extra = append(extra, [2]int{u, v})
+		}
+	}
+
+	b.rng.Shuffle(len(extra), func(i, j int) {
+		extra[i], extra[j] = extra[j], extra[i]
+	})
+
+	for _, e := range extra[:m-(n-1)] {
+		b.edgeWt(e[0], e[1], b.weight(mid, b.maxWt))
+	}
+
+	return b.g, parent, nil
+}
+
+// dag adds a random edge from every vertex to every vertex that comes later in the given order, with probability p.
+func (b *builder) dag(order []int, p float64) {
+	for i, u := range order {
+		for _, v := range order[i+1:] {
+			if b.rng.Float64() < p {
+				b.edge(u, v)
+			}
+		}
+	}
+}
+
+/*
+OrderedDAG generates a random DAG (Directed Acyclic Graph), given a topological order for it, as a permutation
+of the indexes of its vertices: every possible edge from a vertex to a vertex that comes later in the order
+exists with probability p, independently from every other edge. The generated graph is always directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func OrderedDAG(order []int, p float64, opts ...Opt) (*ds.G, error) {
+	if p < 0 || p > 1 {
+		return nil, ErrInvParam
+	}
+
+	seen := make([]bool, len(order))
+
+	for _, v := range order {
+		if v < 0 || v >= len(order) || seen[v] {
+			return nil, ErrInvParam
+		}
+
+		seen[v] = true
+	}
+
+	b, err := newBuilder(len(order), append([]Opt{Directed()}, opts...))
+
+	if err != nil {
+		return nil, err
+	}
+
+	b.dag(order, p)
+
+	return b.g, nil
+}
+
+/*
+PlantedDAG generates a random DAG (see OrderedDAG), given a random topological order,
+which is returned along with it. The generated graph is always directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func PlantedDAG(n int, p float64, opts ...Opt) (*ds.G, []int, error) {
+	if p < 0 || p > 1 {
+		return nil, nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, append([]Opt{Directed()}, opts...))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order := b.rng.Perm(n)
+
+	b.dag(order, p)
+
+	return b.g, order, nil
+}
+
+/*
+PlantedSCCs generates a random directed graph whose SCCs (Strongly Connected Components) have the given sizes,
+returning it along with its SCCs, as the sorted indexes of their vertices, which are randomly assigned to them.
+The vertices of every SCC are connected by a cycle, and every other edge between them exists with probability
+pIn, while an edge from an SCC to a later one exists with probability pOut, and never to an earlier one,
+so the planted SCCs are exactly the SCCs of the graph. The generated graph is always directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func PlantedSCCs(sizes []int, pIn, pOut float64, opts ...Opt) (*ds.G, [][]int, error) {
+	n, err := groupCount(sizes, pIn, pOut)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := newBuilder(n, append([]Opt{Directed()}, opts...))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sccs := b.groups(sizes)
+
+	for i, scc := range sccs {
+		s := len(scc)
+
+		for j, u := range scc {
+			for k, v := range scc {
+				if j == k {
+					continue
+				}
+
+				if k == (j+1)%s || b.rng.Float64() < pIn {
+					b.edge(u, v)
+				}
+			}
+
+			for _, later := range sccs[i+1:] {
+				for _, v := range later {
+					if b.rng.Float64() < pOut {
+						b.edge(u, v)
+					}
+				}
+			}
+		}
+	}
+
+	return b.g, sccs, nil
+}
+
+/*
+PlantedPartition generates a random graph using the planted partition model: vertices are randomly assigned to
+communities with the given sizes, and every possible edge between distinct vertices exists with probability pIn,
+if they are in the same community, and with probability pOut otherwise, independently from every other edge.
+The graph is returned along with its communities, as the sorted indexes of their vertices.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func PlantedPartition(sizes []int, pIn, pOut float64, opts ...Opt) (*ds.G, [][]int, error) {
+	n, err := groupCount(sizes, pIn, pOut)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comms := b.groups(sizes)
+	comm := make([]int, n)
+
+	for i, c := range comms {
+		for _, v := range c {
+			comm[v] = i
+		}
+	}
+
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u == v || (!b.dir && v < u) {
+				continue
+			}
+
+			p := pOut
+
+			if comm[u] == comm[v] {
+				p = pIn
+			}
+
+			if b.rng.Float64() < p {
+				b.edge(u, v)
+			}
+		}
+	}
+
+	return b.g, comms, nil
+}
+
+// groupCount validates the parameters of a generator that plants groups of vertices, counting their vertices.
+func groupCount(sizes []int, pIn, pOut float64) (int, error) {
+	if pIn < 0 || pIn > 1 || pOut < 0 || pOut > 1 {
+		return 0, ErrInvParam
+	}
+
+	n := 0
+
+	for _, s := range sizes {
+		if s < 1 {
+			return 0, ErrInvParam
+		}
+
+		n += s
+	}
+
+	return n, nil
+}
+
+/*
+NegativeCycle generates a random directed graph with a planted negative cycle, returning it along with the
+vertices of the cycle, in order. The cycle goes through k randomly chosen vertices, where 2 <= k <= n,
+and is reachable from the vertex 0. Every other possible edge exists with probability p.
+
+Edges outside the cycle have non-negative weights, chosen from the range given by Weights, which
+cannot hold negative weights, or from [0, 1) by default, while edges of the cycle have their weights
+chosen from the same range, except for the last one, whose weight makes the total weight of the
+cycle -1. The generated graph is always directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func NegativeCycle(n, k int, p float64, opts ...Opt) (*ds.G, []int, error) {
+	if k < 2 || k > n || p < 0 || p > 1 {
+		return nil, nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, append([]Opt{Directed(), Weights(0, 1)}, opts...))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if b.minWt < 0 {
+		return nil, nil, ErrInvParam
+	}
+
+	cycle := b.rng.Perm(n)[:k]
+
+	// next and wt hold the next vertex in the cycle,
+	// and the weight of the edge that leads to it
+	next := make([]int, n)
+	wt := make([]float64, n)
+
+	for v := range next {
+		next[v] = -1
+	}
+
+	total := 0.0
+
+	for i, u := range cycle {
+		next[u] = cycle[(i+1)%k]
+
+		if i < k-1 {
+			wt[u] = b.weight(b.minWt, b.maxWt)
+			total += wt[u]
+		}
+	}
+
+	wt[cycle[k-1]] = -1 - total
+
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			switch {
+
+			case u == v:
+				continue
+
+			case next[u] == v:
+				b.edgeWt(u, v, wt[u])
+
+			// the cycle can always be reached from the vertex 0
+			case u == 0 && v == cycle[0] && next[0] == -1:
+				b.edge(u, v)
+
+			case b.rng.Float64() < p:
+				b.edge(u, v)
+			}
+		}
+	}
+
+	return b.g, cycle, nil
+}
@@ -0,0 +1,250 @@
+package gen
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+// checkTree checks that the parent of every vertex is connected to it, with the vertex 0 as the root.
+func checkTree(t *testing.T, g *ds.G, parent []int) {
+	ut.Equal(t, g.VertexCount(), len(parent))
+	ut.Equal(t, -1, parent[0])
+
+	for v := 1; v < len(parent); v++ {
+		_, _, ok := g.EdgeIndex(g.V[parent[v]].Item, g.V[v].Item)
+		ut.True(t, ok)
+
+		// following the parents always reaches the root
+		steps := 0
+
+		for u := v; u != 0; u = parent[u] {
+			steps++
+			ut.True(t, steps < len(parent))
+		}
+	}
+}
+
+func TestRandomTree(t *testing.T) {
+	for _, opts := range [][]Opt{nil, {Directed()}} {
+		g, parent, err := RandomTree(30, append(opts, Seed(11))...)
+
+		ut.Nil(t, err)
+		ut.Equal(t, 29, g.EdgeCount())
+
+		checkTree(t, g, parent)
+	}
+
+	g, parent, err := RandomTree(1)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 0, g.EdgeCount())
+	ut.Equal(t, -1, parent[0])
+
+	_, _, err = RandomTree(0)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestConnected(t *testing.T) {
+	g, parent, err := Connected(20, 60, Seed(4), Weights(1, 10))
+
+	ut.Nil(t, err)
+	ut.Equal(t, 60, g.EdgeCount())
+
+	checkTree(t, g, parent)
+	checkSimple(t, g)
+
+	ccs, err := algo.CCDFS(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 1, len(ccs))
+
+	// the planted tree is a minimum spanning tree
+	mst, err := algo.MSTKruskal(g)
+
+	ut.Nil(t, err)
+
+	mstWt, treeWt := 0.0, 0.0
+
+	for _, e := range mst {
+		mstWt += e.Wt
+	}
+
+	for v := 1; v < len(parent); v++ {
+		src, e, _ := g.EdgeIndex(g.V[parent[v]].Item, g.V[v].Item)
+		treeWt += g.V[src].E[e].Wt
+	}
+
+	ut.True(t, math.Abs(mstWt-treeWt) < 1e-9)
+
+	g, parent, err = Connected(20, 19, Directed())
+
+	ut.Nil(t, err)
+	ut.Equal(t, 19, g.EdgeCount())
+
+	checkTree(t, g, parent)
+
+	_, _, err = Connected(20, 18)
+	ut.True(t, errors.Is(err, ErrInvParam))
+
+	_, _, err = Connected(5, 11)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestPlantedDAG(t *testing.T) {
+	g, order, err := PlantedDAG(20, 0.4, Seed(6))
+
+	ut.Nil(t, err)
+	ut.True(t, g.Directed())
+
+	pos := make([]int, len(order))
+
+	for i, v := range order {
+		pos[v] = i
+	}
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			ut.True(t, pos[e.Src] < pos[e.Dst])
+		}
+	}
+
+	_, err = algo.TSort(g)
+	ut.Nil(t, err)
+
+	g, err = OrderedDAG([]int{2, 0, 1}, 1)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 3, g.EdgeCount())
+
+	_, _, ok := g.EdgeIndex(Vertex(2), Vertex(0))
+	ut.True(t, ok)
+
+	_, err = OrderedDAG([]int{2, 0, 2}, 1)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestPlantedSCCs(t *testing.T) {
+	sizes := []int{1, 4, 3, 5}
+
+	g, sccs, err := PlantedSCCs(sizes, 0.3, 0.3, Seed(2))
+
+	ut.Nil(t, err)
+	ut.True(t, g.Directed())
+	ut.Equal(t, 13, g.VertexCount())
+	ut.Equal(t, len(sizes), len(sccs))
+
+	found, err := algo.SCCTarjan(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, len(sccs), len(found))
+
+	planted := map[int]int{}
+
+	for i, scc := range sccs {
+		ut.Equal(t, sizes[i], len(scc))
+		ut.True(t, sort.IntsAreSorted(scc))
+
+		for _, v := range scc {
+			planted[v] = i
+		}
+	}
+
+	for _, scc := range found {
+		for _, v := range scc {
+			ut.Equal(t, planted[scc[0]], planted[v])
+		}
+	}
+
+	_, _, err = PlantedSCCs([]int{2, 0}, 0.5, 0.5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestPlantedPartition(t *testing.T) {
+	g, comms, err := PlantedPartition([]int{5, 5}, 1, 0, Seed(8))
+
+	ut.Nil(t, err)
+	ut.True(t, g.Undirected())
+
+	// complete communities, without edges between them
+	ut.Equal(t, 20, g.EdgeCount())
+
+	ccs, err := algo.CCDFS(g)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 2, len(ccs))
+
+	for _, c := range comms {
+		ut.Equal(t, 5, len(c))
+
+		for _, u := range c {
+			for _, v := range c {
+				if u != v {
+					_, _, ok := g.EdgeIndex(Vertex(u), Vertex(v))
+					ut.True(t, ok)
+				}
+			}
+		}
+	}
+
+	g, _, err = PlantedPartition([]int{3, 2}, 1, 1, Directed())
+
+	ut.Nil(t, err)
+	ut.Equal(t, 20, g.EdgeCount())
+
+	_, _, err = PlantedPartition([]int{3, 2}, 1, 2)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestNegativeCycle(t *testing.T) {
+	for _, p := range []float64{0, 0.3} {
+		g, cycle, err := NegativeCycle(15, 4, p, Seed(10), Weights(1, 5))
+
+		ut.Nil(t, err)
+		ut.True(t, g.Directed())
+		ut.Equal(t, 4, len(cycle))
+
+		total := 0.0
+
+		for i, u := range cycle {
+			v := cycle[(i+1)%len(cycle)]
+
+			src, e, ok := g.EdgeIndex(Vertex(u), Vertex(v))
+			ut.True(t, ok)
+
+			total += g.V[src].E[e].Wt
+		}
+
+		ut.True(t, total < 0 && total > -1.0001)
+
+		// every other edge has a non-negative weight
+		negative := 0
+
+		for v := range g.V {
+			for _, e := range g.V[v].E {
+				if e.Wt < 0 {
+					negative++
+				}
+			}
+		}
+
+		ut.Equal(t, 1, negative)
+
+		// the cycle is reachable from the vertex 0
+		tree, err := algo.BFS(g, 0)
+
+		ut.Nil(t, err)
+		ut.False(t, math.IsInf(tree[cycle[0]].Distance, 1))
+	}
+
+	_, _, err := NegativeCycle(5, 1, 0.5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+
+	_, _, err = NegativeCycle(5, 3, 0.5, Weights(-1, 1))
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
@@ -0,0 +1,282 @@
+package gen
+
+import "github.com/vc-souza/gga/ds"
+
+/*
+GNP generates an Erdős–Rényi random graph G(n, p), where every possible edge between
+distinct vertices exists with probability p, independently from every other edge.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func GNP(n int, p float64, opts ...Opt) (*ds.G, error) {
+	if p < 0 || p > 1 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u == v || (!b.dir && v < u) {
+				continue
+			}
+
+			if b.rng.Float64() < p {
+				b.edge(u, v)
+			}
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+GNM generates an Erdős–Rényi random graph G(n, m), uniformly chosen among every graph with
+n vertices and m edges between distinct vertices, with m being at most the number of such edges.
+Edges are added in the order in which they are chosen.
+
+When more than half of the possible edges are needed, the edges left out are chosen instead,
+keeping the expected number of attempts to choose a new edge below 2.
+
+Complexity:
+	- Time:  O(V + E) expected, if E is at most half of the possible edges, Θ(V²) otherwise
+	- Space: O(V + E) if E is at most half of the possible edges, Θ(V²) otherwise
+*/
+func GNM(n, m int, opts ...Opt) (*ds.G, error) {
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	total := b.maxEdges(n)
+
+	if m < 0 || m > total {
+		return nil, ErrInvParam
+	}
+
+	left := m > total/2
+	count := m
+
+	if left {
+		count = total - m
+	}
+
+	chosen := map[[2]int]bool{}
+	order := [][2]int{}
+
+	for len(order) < count {
+		u, v := b.rng.Intn(n), b.rng.Intn(n)
+
+		if u == v {
+			continue
+		}
+
+		if !b.dir && v < u {
+			u, v = v, u
+		}
+
+		if chosen[[2]int{u, v}] {
+			continue
+		}
+
+		chosen[[2]int{u, v}] = true
+		order = append(order, [2]int{u, v})
+	}
+
+	if !left {
+		for _, e := range order {
+			b.edge(e[0], e[1])
+		}
+
+		return b.g, nil
+	}
+
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u == v || (!b.dir && v < u) || chosen[[2]int{u, v}] {
+				continue
+			}
+
+			b.edge(u, v)
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+BarabasiAlbert generates a Barabási–Albert random graph, using preferential attachment: starting from m
+vertices without edges, every new vertex is connected to m distinct existing vertices, each one chosen with
+probability proportional to its degree, where 1 <= m < n. If the graph is directed, every edge goes from
+the new vertex to an existing one, and the total degree of existing vertices is used.
+
+Complexity:
+	- Time:  O(V + E) expected
+	- Space: Θ(V + E)
+*/
+func BarabasiAlbert(n, m int, opts ...Opt) (*ds.G, error) {
+	if m < 1 || m >= n {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// every vertex is repeated once for each edge incident on it,
+	// so uniformly choosing from it follows the degree distribution
+	repeated := []int{}
+
+	targets := make([]int, m)
+
+	for i := range targets {
+		targets[i] = i
+	}
+
+	for v := m; v < n; v++ {
+		for _, u := range targets {
+			b.edge(v, u)
+			repeated = append(repeated, u, v)
+		}
+
+		seen := map[int]bool{}
+		targets = targets[:0]
+
+		for len(targets) < m {
+			u := repeated[b.rng.Intn(len(repeated))]
+
+			if !seen[u] {
+				seen[u] = true
+				targets = append(targets, u)
+			}
+		}
+	}
+
+	return b.g, nil
+}
+
+/*
+WattsStrogatz generates a Watts–Strogatz small-world random graph: starting from a ring lattice where every
+vertex is connected to its k nearest neighbors, k / 2 on each side, every edge (u, v) is rewired with
+probability p, becoming an edge (u, w), with w being chosen uniformly among the vertices not yet adjacent to u.
+The number of neighbors k must be even, and smaller than n. If the graph is directed, every edge of the
+lattice goes from a vertex to one of its next k / 2 neighbors.
+
+Complexity:
+	- Time:  O(V * k) expected, as long as k is at most half of V
+	- Space: Θ(V * k)
+*/
+func WattsStrogatz(n, k int, p float64, opts ...Opt) (*ds.G, error) {
+	if k < 0 || k%2 != 0 || k >= n || p < 0 || p > 1 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	adj := make([]map[int]bool, n)
+
+	for u := range adj {
+		adj[u] = map[int]bool{}
+	}
+
+	link := func(u, v int, on bool) {
+		if on {
+			adj[u][v] = true
+		} else {
+			delete(adj[u], v)
+		}
+
+		if !b.dir {
+			if on {
+				adj[v][u] = true
+			} else {
+				delete(adj[v], u)
+			}
+		}
+	}
+
+	es := [][2]int{}
+
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			v := (u + j) % n
+
+			link(u, v, true)
+			es = append(es, [2]int{u, v})
+		}
+	}
+
+	for i, e := range es {
+		u := e[0]
+
+		if b.rng.Float64() >= p {
+			continue
+		}
+
+		// u is already adjacent to every other vertex
+		if len(adj[u]) >= n-1 {
+			continue
+		}
+
+		w := b.rng.Intn(n)
+
+		for w == u || adj[u][w] {
+			w = b.rng.Intn(n)
+		}
+
+		link(u, e[1], false)
+		link(u, w, true)
+
+		es[i][1] = w
+	}
+
+	for _, e := range es {
+		b.edge(e[0], e[1])
+	}
+
+	return b.g, nil
+}
+
+/*
+RandomDAG generates a random DAG (Directed Acyclic Graph), where every possible edge from a vertex
+to a vertex with a higher index exists with probability p, independently from every other edge,
+so vertices are always in topological order. The generated graph is always directed.
+
+Complexity:
+	- Time:  Θ(V²)
+	- Space: O(V²)
+*/
+func RandomDAG(n int, p float64, opts ...Opt) (*ds.G, error) {
+	if p < 0 || p > 1 {
+		return nil, ErrInvParam
+	}
+
+	b, err := newBuilder(n, append([]Opt{Directed()}, opts...))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if b.rng.Float64() < p {
+				b.edge(u, v)
+			}
+		}
+	}
+
+	return b.g, nil
+}
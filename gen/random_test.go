@@ -0,0 +1,162 @@
+package gen
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestRandom_reproducible(t *testing.T) {
+	cases := []struct {
+		desc string
+		gen  func(opts ...Opt) (*ds.G, error)
+	}{
+		{"gnp", func(opts ...Opt) (*ds.G, error) { return GNP(20, 0.3, opts...) }},
+		{"gnm", func(opts ...Opt) (*ds.G, error) { return GNM(20, 40, opts...) }},
+		{"barabasi-albert", func(opts ...Opt) (*ds.G, error) { return BarabasiAlbert(20, 3, opts...) }},
+		{"watts-strogatz", func(opts ...Opt) (*ds.G, error) { return WattsStrogatz(20, 4, 0.3, opts...) }},
+		{"dag", func(opts ...Opt) (*ds.G, error) { return RandomDAG(20, 0.3, opts...) }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			g1, err := tc.gen(Seed(42), Weights(0, 1))
+			ut.Nil(t, err)
+
+			g2, err := tc.gen(Seed(42), Weights(0, 1))
+			ut.Nil(t, err)
+
+			ut.Equal(t, g1.String(), g2.String())
+
+			g3, err := tc.gen(Seed(43), Weights(0, 1))
+			ut.Nil(t, err)
+
+			ut.False(t, g1.String() == g3.String())
+
+			checkSimple(t, g1)
+		})
+	}
+}
+
+func TestGNP(t *testing.T) {
+	g, err := GNP(10, 0)
+	ut.Nil(t, err)
+	ut.Equal(t, 0, g.EdgeCount())
+
+	g, err = GNP(10, 1)
+	ut.Nil(t, err)
+	ut.Equal(t, 45, g.EdgeCount())
+
+	g, err = GNP(10, 1, Directed())
+	ut.Nil(t, err)
+	ut.Equal(t, 90, g.EdgeCount())
+
+	_, err = GNP(10, 1.5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestGNM(t *testing.T) {
+	// both choosing the edges that are in, and the edges that are out
+	for _, m := range []int{0, 10, 30, 44, 45} {
+		g, err := GNM(10, m, Seed(int64(m)))
+
+		ut.Nil(t, err)
+		ut.Equal(t, m, g.EdgeCount())
+
+		checkSimple(t, g)
+	}
+
+	g, err := GNM(10, 80, Directed())
+
+	ut.Nil(t, err)
+	ut.Equal(t, 80, g.EdgeCount())
+
+	checkSimple(t, g)
+
+	_, err = GNM(10, 46)
+	ut.True(t, errors.Is(err, ErrInvParam))
+
+	_, err = GNM(10, -1)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	for _, dir := range []bool{false, true} {
+		opts := []Opt{Seed(3)}
+
+		if dir {
+			opts = append(opts, Directed())
+		}
+
+		g, err := BarabasiAlbert(50, 3, opts...)
+
+		ut.Nil(t, err)
+		ut.Equal(t, 50, g.VertexCount())
+		ut.Equal(t, 47*3, g.EdgeCount())
+
+		checkSimple(t, g)
+	}
+
+	_, err := BarabasiAlbert(5, 5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+
+	_, err = BarabasiAlbert(5, 0)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	g, err := WattsStrogatz(10, 4, 0)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 20, g.EdgeCount())
+
+	// without rewiring, the ring lattice is kept
+	for v := range g.V {
+		ut.Equal(t, 4, len(g.V[v].E))
+	}
+
+	for _, p := range []float64{0.5, 1} {
+		g, err = WattsStrogatz(10, 4, p, Seed(5))
+
+		ut.Nil(t, err)
+		ut.Equal(t, 20, g.EdgeCount())
+
+		checkSimple(t, g)
+
+		g, err = WattsStrogatz(10, 4, p, Seed(5), Directed())
+
+		ut.Nil(t, err)
+		ut.Equal(t, 20, g.EdgeCount())
+
+		checkSimple(t, g)
+	}
+
+	_, err = WattsStrogatz(10, 3, 0.5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+
+	_, err = WattsStrogatz(4, 4, 0.5)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
+
+func TestRandomDAG(t *testing.T) {
+	g, err := RandomDAG(15, 0.5, Seed(9))
+
+	ut.Nil(t, err)
+	ut.True(t, g.Directed())
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			ut.True(t, e.Src < e.Dst)
+		}
+	}
+
+	g, err = RandomDAG(15, 1)
+
+	ut.Nil(t, err)
+	ut.Equal(t, 105, g.EdgeCount())
+
+	_, err = RandomDAG(15, -0.1)
+	ut.True(t, errors.Is(err, ErrInvParam))
+}
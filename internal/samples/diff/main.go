@@ -0,0 +1,109 @@
+//go:build !test
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vc-souza/gga/ds"
+	"github.com/vc-souza/gga/viz"
+)
+
+const (
+	fileIn  = "DIFF-before.dot"
+	fileOut = "DIFF-after.dot"
+)
+
+// before is the dependency graph of a small service, weighted by the number of calls.
+const before = `
+digraph
+api#auth:3,users:5,cache:2
+auth#users:1
+users#db:4
+cache#
+db#
+`
+
+// after is the same dependency graph, once the cache is replaced by a queue.
+const after = `
+digraph
+api#auth:3,users:2,queue:1
+auth#users:1
+users#db:4,queue:2
+queue#
+db#
+`
+
+func input(s string) *ds.G {
+	g, _, err := ds.Parse(s)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+func exportStart(g *ds.G) {
+	fIn, err := os.Create(fileIn)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fIn.Close()
+
+	viz.Snapshot(g, fIn, viz.Themes.LightBreeze)
+}
+
+func exportEnd(v viz.AlgoViz) {
+	fOut, err := os.Create(fileOut)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fOut.Close()
+
+	if err := viz.ExportViz(v, fOut); err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	from, to := input(before), input(after)
+
+	exportStart(from)
+
+	vi, err := viz.NewDiffViz(from, to, viz.Themes.LightBreeze)
+
+	if err != nil {
+		panic(err)
+	}
+
+	vi.OnAddedVertex = func(v int) {
+		vi.Graph.V[v].SetFmtAttr("fillcolor", "#43b581")
+	}
+
+	vi.OnRemovedVertex = func(v int) {
+		vi.Graph.V[v].SetFmtAttr("fillcolor", "#f04747")
+	}
+
+	vi.OnAddedEdge = func(v int, e int) {
+		vi.Graph.V[v].E[e].SetFmtAttr("color", "#43b581")
+		vi.Graph.V[v].E[e].SetFmtAttr("penwidth", "2.0")
+	}
+
+	vi.OnRemovedEdge = func(v int, e int) {
+		vi.Graph.V[v].E[e].SetFmtAttr("color", "#f04747")
+		vi.Graph.V[v].E[e].SetFmtAttr("style", "dashed")
+	}
+
+	vi.OnReweightedEdge = func(v int, e int, old float64) {
+		vi.Graph.V[v].E[e].SetFmtAttr("color", "#faa61a")
+		vi.Graph.V[v].E[e].SetFmtAttr("label", fmt.Sprintf("%.2f →", old))
+	}
+
+	exportEnd(vi)
+}
@@ -0,0 +1,99 @@
+//go:build !test
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+	"github.com/vc-souza/gga/viz"
+)
+
+const (
+	fileIn  = "KCore-before.dot"
+	fileOut = "KCore-after.dot"
+)
+
+// shades holds fill colors for vertices, from the outermost to the innermost core.
+var shades = []string{
+	"#c9d3f5",
+	"#a3b4ec",
+	"#7289da",
+	"#4e64b8",
+	"#2f4391",
+}
+
+func input() *ds.G {
+	g, _, err := ds.Parse(ut.UUGSimple)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+func exportStart(g *ds.G) {
+	fIn, err := os.Create(fileIn)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fIn.Close()
+
+	viz.Snapshot(g, fIn, viz.Themes.LightBreeze)
+}
+
+func exportEnd(v viz.AlgoViz) {
+	fOut, err := os.Create(fileOut)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fOut.Close()
+
+	if err := viz.ExportViz(v, fOut); err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	g := input()
+
+	exportStart(g)
+
+	cores, _, err := algo.KCore(g)
+
+	if err != nil {
+		panic(err)
+	}
+
+	vi := viz.NewKCoreViz(g, cores, viz.Themes.LightBreeze)
+
+	shade := func(k int) string {
+		if vi.Degeneracy == 0 {
+			return shades[0]
+		}
+
+		return shades[k*(len(shades)-1)/vi.Degeneracy]
+	}
+
+	vi.OnCoreVertex = func(v int, k int) {
+		label := fmt.Sprintf(`{ %s | core %d }`, vi.Graph.V[v].Label(), k)
+		vi.Graph.V[v].SetFmtAttr("label", label)
+		vi.Graph.V[v].SetFmtAttr("fillcolor", shade(k))
+	}
+
+	vi.OnCoreEdge = func(v int, e int, k int) {
+		if k == vi.Degeneracy {
+			vi.Graph.V[v].E[e].SetFmtAttr("penwidth", "2.0")
+		}
+	}
+
+	exportEnd(vi)
+}
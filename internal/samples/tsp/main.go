@@ -0,0 +1,127 @@
+//go:build !test
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+	"github.com/vc-souza/gga/viz"
+)
+
+const (
+	fileIn  = "TSP-before.dot"
+	fileOut = "TSP-after.dot"
+)
+
+var algos = map[string]algo.TSPAlgo{
+	"double-tree":  algo.TSPDoubleTree,
+	"christofides": algo.TSPChristofides,
+}
+
+// cities holds the coordinates of each city on a plane.
+var cities = map[string][2]float64{
+	"a": {0, 0},
+	"b": {4, 1},
+	"c": {7, 0},
+	"d": {8, 4},
+	"e": {5, 6},
+	"f": {1, 5},
+	"g": {3, 3},
+}
+
+func input() *ds.G {
+	g := ds.NewGraph()
+
+	names := []string{"a", "b", "c", "d", "e", "f", "g"}
+
+	for _, n := range names {
+		g.AddVertex(ds.Text(n))
+	}
+
+	for i, src := range names {
+		for _, dst := range names[i+1:] {
+			p, q := cities[src], cities[dst]
+			wt := math.Round(math.Hypot(p[0]-q[0], p[1]-q[1])*10) / 10
+
+			g.AddEdge(ds.Text(src), ds.Text(dst), wt)
+		}
+	}
+
+	return g
+}
+
+func exportStart(g *ds.G) {
+	fIn, err := os.Create(fileIn)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fIn.Close()
+
+	viz.Snapshot(g, fIn, viz.Themes.LightBreeze)
+}
+
+func exportEnd(v viz.AlgoViz) {
+	fOut, err := os.Create(fileOut)
+
+	if err != nil {
+		panic(err)
+	}
+
+	defer fOut.Close()
+
+	if err := viz.ExportViz(v, fOut); err != nil {
+		panic(err)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		panic("Too few args!")
+	}
+
+	if len(os.Args) > 2 {
+		panic("Too many args!")
+	}
+
+	build, ok := algos[os.Args[1]]
+
+	if !ok {
+		panic(fmt.Sprintf("invalid option '%s'", os.Args[1]))
+	}
+
+	g := input()
+
+	exportStart(g)
+
+	tour, _, err := algo.TSPApprox(g, build, algo.TSP2Opt, algo.TSPOrOpt)
+
+	if err != nil {
+		panic(err)
+	}
+
+	vi := viz.NewTourViz(g, tour, viz.Themes.LightBreeze)
+
+	// formatting is reset right before the traversal,
+	// and every vertex is visited before any edge
+	vi.OnTourVertex = func(v int, pos int) {
+		label := fmt.Sprintf(`{ %s | %d }`, vi.Graph.V[v].Label(), pos+1)
+		vi.Graph.V[v].SetFmtAttr("label", label)
+
+		for e := range vi.Graph.V[v].E {
+			vi.Graph.V[v].E[e].SetFmtAttr("style", "invis")
+		}
+	}
+
+	vi.OnTourEdge = func(v int, e int) {
+		vi.Graph.V[v].E[e].SetFmtAttr("style", "solid")
+		vi.Graph.V[v].E[e].SetFmtAttr("penwidth", "2.0")
+	}
+
+	exportEnd(vi)
+}
@@ -32,13 +32,13 @@ type BFSViz struct {
 }
 
 // NewBFSViz initializes a new BFSViz with NOOP hooks.
-func NewBFSViz(g *ds.G, tree algo.BFTree, src int, t Theme) *BFSViz {
+func NewBFSViz(g VizGraph, tree algo.BFTree, src int, t Theme) *BFSViz {
 	res := &BFSViz{}
 
 	res.Tree = tree
 	res.Source = src
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnUnVertex = func(int, algo.BFNode) {}
@@ -51,6 +51,8 @@ func NewBFSViz(g *ds.G, tree algo.BFTree, src int, t Theme) *BFSViz {
 
 // Traverse iterates over the results of a BFS execution, calling its hooks when appropriate.
 func (vi *BFSViz) Traverse() error {
+	g := vi.GetView()
+
 	for v, node := range vi.Tree {
 		if math.IsInf(node.Distance, 1) {
 			vi.OnUnVertex(v, node)
@@ -64,9 +66,9 @@ func (vi *BFSViz) Traverse() error {
 			continue
 		}
 
-		iV, iE, ok := vi.Graph.EdgeIndex(
-			vi.Graph.V[node.Parent].Item,
-			vi.Graph.V[v].Item,
+		iV, iE, ok := g.EdgeIndex(
+			g.Item(node.Parent),
+			g.Item(v),
 		)
 
 		if !ok {
@@ -75,13 +77,13 @@ func (vi *BFSViz) Traverse() error {
 
 		vi.OnTreeEdge(iV, iE)
 
-		if vi.Graph.Directed() {
+		if g.Directed() {
 			continue
 		}
 
-		iV, iE, ok = vi.Graph.EdgeIndex(
-			vi.Graph.V[v].Item,
-			vi.Graph.V[node.Parent].Item,
+		iV, iE, ok = g.EdgeIndex(
+			g.Item(v),
+			g.Item(node.Parent),
 		)
 
 		if !ok {
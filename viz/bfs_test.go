@@ -1,6 +1,8 @@
 package viz
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/vc-souza/gga/algo"
@@ -76,3 +78,33 @@ func TestBFSViz(t *testing.T) {
 		})
 	}
 }
+
+func TestBFSViz_matrix(t *testing.T) {
+	g, idx, err := ds.Parse(ut.UUGSimple)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(t, g)
+	src := idx("u")
+
+	tree, err := algo.BFS(m, src)
+
+	ut.Nil(t, err)
+
+	vi := NewBFSViz(m, tree, src, nil)
+
+	ut.True(t, vi.Graph == nil)
+
+	vi.OnTreeEdge = func(v int, e int) {
+		vi.View.EdgeFmt(v, e).SetFmtAttr("penwidth", "3.0")
+	}
+
+	buf := bytes.Buffer{}
+
+	err = ExportViz(vi, &buf)
+
+	ut.Nil(t, err)
+
+	// every vertex but the source is reached through a tree edge, exported along with its reverse copy
+	ut.Equal(t, 2*(g.VertexCount()-1), strings.Count(buf.String(), `penwidth="3.0"`))
+}
@@ -24,12 +24,12 @@ type CCViz struct {
 }
 
 // NewCCViz initializes a new CCViz with NOOP hooks.
-func NewCCViz(g *ds.G, ccs []algo.CC, t Theme) *CCViz {
+func NewCCViz(g VizGraph, ccs []algo.CC, t Theme) *CCViz {
 	res := &CCViz{}
 
 	res.CCs = ccs
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnCCVertex = func(int, int) {}
@@ -40,13 +40,15 @@ func NewCCViz(g *ds.G, ccs []algo.CC, t Theme) *CCViz {
 
 // Traverse iterates over the results of any CC algorithm, calling its hooks when appropriate.
 func (vi *CCViz) Traverse() error {
+	g := vi.GetView()
+
 	for i := range vi.CCs {
 		for _, v := range vi.CCs[i] {
 			vi.OnCCVertex(v, i)
 
-			for e := range vi.Graph.V[v].E {
-				vi.OnCCEdge(v, e, i)
-			}
+			g.ForEachEdge(v, func(e ds.GE) {
+				vi.OnCCEdge(v, e.Index, i)
+			})
 		}
 	}
 
@@ -35,5 +35,6 @@ func TestCCViz(t *testing.T) {
 	ut.Nil(t, err)
 
 	ut.Equal(t, g.VertexCount(), vCount)
-	ut.Equal(t, g.EdgeCount(), eCount)
+	// every undirected edge is visited from both of its vertices
+	ut.Equal(t, 2*g.EdgeCount(), eCount)
 }
@@ -36,13 +36,13 @@ type DFSViz struct {
 }
 
 // NewDFSViz initializes a new DFSViz with NOOP hooks.
-func NewDFSViz(g *ds.G, f algo.DFForest, e algo.EdgeTypes, t Theme) *DFSViz {
+func NewDFSViz(g VizGraph, f algo.DFForest, e algo.EdgeTypes, t Theme) *DFSViz {
 	res := &DFSViz{}
 
 	res.Forest = f
 	res.Edges = e
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnTreeVertex = func(int, algo.DFNode) {}
@@ -58,6 +58,8 @@ func NewDFSViz(g *ds.G, f algo.DFForest, e algo.EdgeTypes, t Theme) *DFSViz {
 
 // Traverse iterates over the results of a DFS execution, calling its hooks when appropriate.
 func (vi *DFSViz) Traverse() error {
+	g := vi.GetView()
+
 	for v, node := range vi.Forest {
 		vi.OnTreeVertex(v, node)
 
@@ -66,9 +68,9 @@ func (vi *DFSViz) Traverse() error {
 			continue
 		}
 
-		iV, iE, ok := vi.Graph.EdgeIndex(
-			vi.Graph.V[node.Parent].Item,
-			vi.Graph.V[v].Item,
+		iV, iE, ok := g.EdgeIndex(
+			g.Item(node.Parent),
+			g.Item(v),
 		)
 
 		if !ok {
@@ -77,13 +79,13 @@ func (vi *DFSViz) Traverse() error {
 
 		vi.OnTreeEdge(iV, iE)
 
-		if vi.Graph.Directed() {
+		if g.Directed() {
 			continue
 		}
 
-		iV, iE, ok = vi.Graph.EdgeIndex(
-			vi.Graph.V[v].Item,
-			vi.Graph.V[node.Parent].Item,
+		iV, iE, ok = g.EdgeIndex(
+			g.Item(v),
+			g.Item(node.Parent),
 		)
 
 		if !ok {
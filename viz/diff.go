@@ -0,0 +1,149 @@
+package viz
+
+import (
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+DiffViz formats and exports the changes between two versions of a graph (see ds.Diff). Both versions
+are merged into a single graph, holding every vertex and edge of either version, with reweighted edges
+holding their new weight. The changes are traversed, and hooks are provided so that custom formatting
+can be applied to the vertices and edges that were added, removed or reweighted.
+*/
+type DiffViz struct {
+	ThemedGraphViz
+
+	Diff *ds.GraphDiff
+
+	// OnAddedVertex is called for every vertex that only exists in the new graph.
+	OnAddedVertex func(int)
+
+	// OnRemovedVertex is called for every vertex that only exists in the old graph.
+	OnRemovedVertex func(int)
+
+	// OnAddedEdge is called for every edge that only exists in the new graph.
+	OnAddedEdge func(int, int)
+
+	// OnRemovedEdge is called for every edge that only exists in the old graph.
+	OnRemovedEdge func(int, int)
+
+	// OnReweightedEdge is called for every edge whose weight changed, along with its old weight.
+	OnReweightedEdge func(int, int, float64)
+}
+
+/*
+NewDiffViz initializes a new DiffViz with NOOP hooks, merging both versions of the graph,
+which can be of any representation, into a new *ds.G.
+*/
+func NewDiffViz(from, to ds.Graph, t Theme) (*DiffViz, error) {
+	d, err := ds.Diff(from, to)
+
+	if err != nil {
+		return nil, err
+	}
+
+	same := func(l string) string { return l }
+
+	g, err := ds.Union(from, to, ds.MatchBy(same), ds.OnConflict(ds.KeepSecond))
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &DiffViz{}
+
+	res.Diff = d
+
+	res.setGraph(g)
+	res.Theme = t
+
+	res.OnAddedVertex = func(int) {}
+	res.OnRemovedVertex = func(int) {}
+	res.OnAddedEdge = func(int, int) {}
+	res.OnRemovedEdge = func(int, int) {}
+	res.OnReweightedEdge = func(int, int, float64) {}
+
+	return res, nil
+}
+
+/*
+edge finds the edge of the merged graph that was changed, calling fn for it, and for its reverse copy, if undirected.
+Edges of either version are merged in order, with matched edges at the same position between their vertices, so
+the change is at the K-th edge between its vertices in the merged graph as well.
+*/
+func (vi *DiffViz) edge(idx map[string]int, c ds.EdgeChange, fn func(int, int)) error {
+	src, dst := idx[c.Src], idx[c.Dst]
+	k := 0
+
+	for e, edge := range vi.Graph.V[src].E {
+		if edge.Dst != dst {
+			continue
+		}
+
+		if k != c.K {
+			k++
+			continue
+		}
+
+		fn(src, e)
+
+		if vi.Graph.Directed() {
+			return nil
+		}
+
+		iV, iE, ok := vi.Graph.ReverseEdge(src, e)
+
+		if !ok {
+			return ds.ErrNoRevEdge
+		}
+
+		fn(iV, iE)
+
+		return nil
+	}
+
+	return ds.ErrNoEdge
+}
+
+// Traverse iterates over the changes between both versions of the graph, calling its hooks when appropriate.
+func (vi *DiffViz) Traverse() error {
+	idx := map[string]int{}
+
+	for v := range vi.Graph.V {
+		idx[vi.Graph.V[v].Label()] = v
+	}
+
+	for _, l := range vi.Diff.AddedVertices {
+		vi.OnAddedVertex(idx[l])
+	}
+
+	for _, l := range vi.Diff.RemovedVertices {
+		vi.OnRemovedVertex(idx[l])
+	}
+
+	for _, c := range vi.Diff.AddedEdges {
+		if err := vi.edge(idx, c, vi.OnAddedEdge); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range vi.Diff.RemovedEdges {
+		if err := vi.edge(idx, c, vi.OnRemovedEdge); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range vi.Diff.ReweightedEdges {
+		c := c
+
+		fn := func(v, e int) {
+			vi.OnReweightedEdge(v, e, c.OldWt)
+		}
+
+		if err := vi.edge(idx, c, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
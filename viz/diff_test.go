@@ -0,0 +1,122 @@
+package viz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestDiffViz(t *testing.T) {
+	from, _, err := ds.Parse(`
+	graph
+	a#b:1,c:2
+	b#a:1
+	c#a:2
+	`)
+
+	ut.Nil(t, err)
+
+	to, _, err := ds.Parse(`
+	graph
+	a#b:5,d:3
+	b#a:5
+	d#a:3
+	`)
+
+	ut.Nil(t, err)
+
+	vi, err := NewDiffViz(from, to, nil)
+
+	ut.Nil(t, err)
+
+	g := vi.Graph
+
+	ut.Equal(t, 4, g.VertexCount())
+	ut.Equal(t, 3, g.EdgeCount())
+
+	added, removed, reweighted := 0, 0, 0
+
+	vi.OnAddedVertex = func(v int) {
+		ut.Equal(t, "d", g.V[v].Label())
+	}
+
+	vi.OnRemovedVertex = func(v int) {
+		ut.Equal(t, "c", g.V[v].Label())
+	}
+
+	vi.OnAddedEdge = func(v, e int) {
+		ut.Equal(t, 3.0, g.V[v].E[e].Wt)
+		added++
+	}
+
+	vi.OnRemovedEdge = func(v, e int) {
+		ut.Equal(t, 2.0, g.V[v].E[e].Wt)
+		removed++
+	}
+
+	vi.OnReweightedEdge = func(v, e int, old float64) {
+		ut.Equal(t, 5.0, g.V[v].E[e].Wt)
+		ut.Equal(t, 1.0, old)
+		reweighted++
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	// both copies of every undirected edge
+	ut.Equal(t, 2, added)
+	ut.Equal(t, 2, removed)
+	ut.Equal(t, 2, reweighted)
+}
+
+func TestDiffViz_mixed(t *testing.T) {
+	_, err := NewDiffViz(ds.NewGraph(), ds.NewDigraph(), nil)
+	ut.True(t, errors.Is(err, ds.ErrMixedGraphs))
+}
+
+func TestDiffViz_multigraph(t *testing.T) {
+	a, b := ds.Text("a"), ds.Text("b")
+
+	from := ds.NewGraphWith(ds.Multigraph())
+	to := ds.NewGraphWith(ds.Multigraph())
+
+	for _, g := range []*ds.G{from, to} {
+		_, err := g.AddVertex(a)
+		ut.Nil(t, err)
+
+		_, err = g.AddVertex(b)
+		ut.Nil(t, err)
+	}
+
+	for _, wt := range []float64{1, 3, 1} {
+		_, _, err := from.AddEdge(a, b, wt)
+		ut.Nil(t, err)
+	}
+
+	for _, wt := range []float64{1, 3} {
+		_, _, err := to.AddEdge(a, b, wt)
+		ut.Nil(t, err)
+	}
+
+	vi, err := NewDiffViz(from, to, nil)
+
+	ut.Nil(t, err)
+
+	removed := [][2]int{}
+
+	vi.OnRemovedEdge = func(v, e int) {
+		removed = append(removed, [2]int{v, e})
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	// the third edge was removed, not the first one, which has the same weight
+	ut.Equal(t, 2, len(removed))
+	ut.Equal(t, [2]int{0, 2}, removed[0])
+	ut.Equal(t, [2]int{1, 2}, removed[1])
+}
@@ -1,5 +1,9 @@
 /*
 Package viz exports the gga graph structs to .dot files, the format supported by Graphviz (https://graphviz.org/).
 The main use case is the ability to visualize a graph before, during and after the execution of an algorithm.
+
+Algorithm visualizations accept any VizGraph, like a *ds.G or a *ds.M, which is kept in their View field.
+When the graph is a *ds.G, it is kept in their Graph field as well, so existing hooks can keep changing it
+directly. Hooks written for any VizGraph change formatting attributes through View (see VizGraph.EdgeFmt).
 */
 package viz
@@ -9,8 +9,8 @@ import (
 )
 
 /*
-Exporter implements the ds.GraphVisitor interface in order to traverse a ds.Graph
-and build a sequence of lines in the DOT language. After a successful visit, these
+Exporter implements the ds.Visitor interface in order to traverse any ds.Visitable
+graph (e.g.: ds.G, ds.M), and the ds.GraphVisitor interface, for a ds.G, and build a sequence of lines in the DOT language. After a successful visit, these
 lines can then be exported to an io.Writer by calling its Export method.
 
 Full specification of the DOT language, by Graphviz can be found here:
@@ -66,8 +66,8 @@ func (d *Exporter) AddExtra(s ...string) {
 }
 
 // Export writes the data it has accumulated to an io.Writer.
-func (d *Exporter) Export(g *ds.G, w io.Writer) {
-	g.Accept(d)
+func (d *Exporter) Export(g ds.Visitable, w io.Writer) {
+	g.Walk(d)
 
 	s := strings.Join(d.Lines, "\n")
 	r := strings.NewReader(s)
@@ -75,7 +75,7 @@ func (d *Exporter) Export(g *ds.G, w io.Writer) {
 	io.Copy(w, r)
 }
 
-func (d *Exporter) VisitGraphStart(g ds.G) {
+func (d *Exporter) VisitStart(g ds.Graph) {
 	var start string
 
 	if g.Directed() {
@@ -88,7 +88,7 @@ func (d *Exporter) VisitGraphStart(g ds.G) {
 	d.addDefaults()
 }
 
-func (d *Exporter) VisitGraphEnd(ds.G) {
+func (d *Exporter) VisitEnd(ds.Graph) {
 	if len(d.Extra) != 0 {
 		d.add(d.Extra...)
 	}
@@ -96,7 +96,7 @@ func (d *Exporter) VisitGraphEnd(ds.G) {
 	d.add("}\n")
 }
 
-func (d *Exporter) VisitVertex(g ds.G, v ds.GV) {
+func (d *Exporter) VisitGV(g ds.Graph, v ds.GV) {
 	d.add(fmt.Sprintf(
 		"%s%s",
 		Quoted(v.Item),
@@ -104,7 +104,7 @@ func (d *Exporter) VisitVertex(g ds.G, v ds.GV) {
 	))
 }
 
-func (d *Exporter) VisitEdge(g ds.G, e ds.GE) {
+func (d *Exporter) VisitGE(g ds.Graph, e ds.GE) {
 	var op string
 
 	if g.Directed() {
@@ -131,13 +131,29 @@ func (d *Exporter) VisitEdge(g ds.G, e ds.GE) {
 
 	d.add(fmt.Sprintf(
 		"%s %s %s%s",
-		Quoted(g.V[e.Src].Item),
+		Quoted(g.Item(e.Src)),
 		op,
-		Quoted(g.V[e.Dst].Item),
+		Quoted(g.Item(e.Dst)),
 		DotAttrs(attrs),
 	))
 }
 
+func (d *Exporter) VisitGraphStart(g ds.G) {
+	d.VisitStart(&g)
+}
+
+func (d *Exporter) VisitGraphEnd(g ds.G) {
+	d.VisitEnd(&g)
+}
+
+func (d *Exporter) VisitVertex(g ds.G, v ds.GV) {
+	d.VisitGV(&g, v)
+}
+
+func (d *Exporter) VisitEdge(g ds.G, e ds.GE) {
+	d.VisitGE(&g, e)
+}
+
 /*
 DotAttrs converts an object holding formatting attributes to its DOT language equivalent.
 Full list of DOT attributes can be found here: https://graphviz.org/doc/info/attrs.html.
@@ -159,18 +175,18 @@ func DotAttrs(f ds.FAttrs) string {
 }
 
 // ResetGraphFmt resets custom formatting attributes for every vertex and edge of a graph.
-func ResetGraphFmt(g *ds.G) {
-	for v := range g.V {
-		g.V[v].ResetFmt()
+func ResetGraphFmt(g VizGraph) {
+	for v := 0; v < g.VertexCount(); v++ {
+		g.VertexFmt(v).ResetFmt()
 
-		for e := range g.V[v].E {
-			g.V[v].E[e].ResetFmt()
-		}
+		g.ForEachEdge(v, func(e ds.GE) {
+			g.EdgeFmt(v, e.Index).ResetFmt()
+		})
 	}
 }
 
 // Snapshot implements a shorthand for the quick export of a graph, using a theme.
-func Snapshot(g *ds.G, w io.Writer, t Theme) {
+func Snapshot(g ds.Visitable, w io.Writer, t Theme) {
 	ex := NewExporter()
 	SetTheme(ex, t)
 	ex.Export(g, w)
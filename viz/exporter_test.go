@@ -2,6 +2,7 @@ package viz
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/vc-souza/gga/ds"
@@ -39,6 +40,36 @@ func (p person) Label() string {
 	return p.Name
 }
 
+// toMatrix copies a graph into a graph backed by an adjacency matrix.
+func toMatrix(t *testing.T, g *ds.G) *ds.M {
+	var m *ds.M
+
+	if g.Directed() {
+		m = ds.NewMatrixDigraph()
+	} else {
+		m = ds.NewMatrixGraph()
+	}
+
+	for v := range g.V {
+		_, err := m.AddVertex(g.V[v].Item)
+		ut.Nil(t, err)
+	}
+
+	for v := range g.V {
+		for _, e := range g.V[v].E {
+			// undirected edges are added once, through one of their copies
+			if g.Undirected() && e.Src > e.Dst {
+				continue
+			}
+
+			_, _, err := m.AddEdge(g.V[e.Src].Item, g.V[e.Dst].Item, e.Wt)
+			ut.Nil(t, err)
+		}
+	}
+
+	return m
+}
+
 func TestGraphVisitor(t *testing.T) {
 	cases := []struct {
 		desc   string
@@ -93,6 +124,74 @@ func TestGraphVisitor(t *testing.T) {
 			e.Export(g, &buf)
 
 			ut.Equal(t, tc.expect, buf.String())
+
+			// visiting the graph as a ds.GraphVisitor yields the same lines
+			acc := NewExporter()
+
+			acc.DefaultGraphFmt = e.DefaultGraphFmt
+			acc.DefaultEdgeFmt = e.DefaultEdgeFmt
+
+			g.Accept(acc)
+
+			ut.Equal(t, tc.expect, strings.Join(acc.Lines, "\n"))
+		})
+	}
+}
+
+func TestGraphVisitor_matrix(t *testing.T) {
+	cases := []struct {
+		desc   string
+		gen    func() *ds.M
+		expect string
+	}{
+		{
+			desc:   "graph",
+			gen:    ds.NewMatrixGraph,
+			expect: ExpectedUndirectedDOT,
+		},
+		{
+			desc:   "digraph",
+			gen:    ds.NewMatrixDigraph,
+			expect: ExpectedDirectedDOT,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := tc.gen()
+			e := NewExporter()
+
+			john := &person{"John"}
+			jane := &person{"Jane"}
+			jonas := &person{"Jonas"}
+
+			e.DefaultGraphFmt = ds.FAttrs{
+				"label": "A Test",
+			}
+
+			e.DefaultEdgeFmt = ds.FAttrs{
+				"arrowhead": "vee",
+			}
+
+			m.AddVertex(jonas)
+
+			iJohn, err := m.AddVertex(john)
+
+			m.AddVertex(jane)
+
+			ut.Nil(t, err)
+
+			m.V[iJohn].SetFmtAttr("shape", "hexagon")
+
+			m.AddEdge(john, jane, 0)
+			m.AddEdge(jane, john, 0)
+			m.AddEdge(jane, jane, 0)
+
+			buf := bytes.Buffer{}
+
+			e.Export(m, &buf)
+
+			ut.Equal(t, tc.expect, buf.String())
 		})
 	}
 }
@@ -192,6 +291,30 @@ func TestResetGraphFmt(t *testing.T) {
 	}
 }
 
+func TestResetGraphFmt_matrix(t *testing.T) {
+	g, _, err := ds.Parse(ut.UUGSimple)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(t, g)
+
+	m.V[0].SetFmtAttr("label", "here")
+
+	m.ForEachEdge(0, func(e ds.GE) {
+		m.EdgeFmt(e.Src, e.Index).SetFmtAttr("label", "connection")
+	})
+
+	ResetGraphFmt(m)
+
+	for v := range m.V {
+		ut.Equal(t, 0, len(m.V[v].F))
+
+		m.ForEachEdge(v, func(e ds.GE) {
+			ut.Equal(t, 0, len(e.F))
+		})
+	}
+}
+
 type exportTestTheme struct{}
 
 func (t exportTestTheme) SetGraphFmt(attrs ds.FAttrs) {
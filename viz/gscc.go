@@ -1,9 +1,5 @@
 package viz
 
-import (
-	"github.com/vc-souza/gga/ds"
-)
-
 /*
 GSCCViz formats and exports a GSCC graph after it has been calculated. Its vertices
 are traversed, and hooks are provided so that custom formatting can be applied.
@@ -16,10 +12,10 @@ type GSCCViz struct {
 }
 
 // NewGSCCViz initializes a new GSCCViz with NOOP hooks.
-func NewGSCCViz(g *ds.G, t Theme) *GSCCViz {
+func NewGSCCViz(g VizGraph, t Theme) *GSCCViz {
 	res := &GSCCViz{}
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnGSCCVertex = func(v int) {}
@@ -29,7 +25,7 @@ func NewGSCCViz(g *ds.G, t Theme) *GSCCViz {
 
 // Traverse iterates over the vertices of a GSCC graph, calling its hooks when appropriate.
 func (vi *GSCCViz) Traverse() error {
-	for v := range vi.Graph.V {
+	for v := 0; v < vi.GetView().VertexCount(); v++ {
 		vi.OnGSCCVertex(v)
 	}
 
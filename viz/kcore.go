@@ -0,0 +1,71 @@
+package viz
+
+import (
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+KCoreViz formats and exports an undirected graph after the execution of the k-core
+decomposition algorithm. The output of the algorithm is traversed, and hooks are
+provided so that custom formatting can be applied to the graph, its vertices and
+edges, like shading vertices according to their core numbers.
+*/
+type KCoreViz struct {
+	ThemedGraphViz
+
+	Cores []int
+
+	// Degeneracy is the largest core number in the graph, useful for normalizing shades.
+	Degeneracy int
+
+	// OnCoreVertex is called for every vertex, along with its core number.
+	OnCoreVertex func(int, int)
+
+	/*
+		OnCoreEdge is called for every edge, along with the core number of the innermost core
+		that contains the edge: the smallest core number between the vertices of the edge.
+	*/
+	OnCoreEdge func(int, int, int)
+}
+
+// NewKCoreViz initializes a new KCoreViz with NOOP hooks.
+func NewKCoreViz(g VizGraph, cores []int, t Theme) *KCoreViz {
+	res := &KCoreViz{}
+
+	res.Cores = cores
+
+	for _, k := range cores {
+		if k > res.Degeneracy {
+			res.Degeneracy = k
+		}
+	}
+
+	res.setGraph(g)
+	res.Theme = t
+
+	res.OnCoreVertex = func(int, int) {}
+	res.OnCoreEdge = func(int, int, int) {}
+
+	return res
+}
+
+// Traverse iterates over the results of a k-core decomposition, calling its hooks when appropriate.
+func (vi *KCoreViz) Traverse() error {
+	g := vi.GetView()
+
+	for v := 0; v < g.VertexCount(); v++ {
+		vi.OnCoreVertex(v, vi.Cores[v])
+
+		g.ForEachEdge(v, func(e ds.GE) {
+			k := vi.Cores[v]
+
+			if kDst := vi.Cores[e.Dst]; kDst < k {
+				k = kDst
+			}
+
+			vi.OnCoreEdge(v, e.Index, k)
+		})
+	}
+
+	return nil
+}
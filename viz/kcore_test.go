@@ -0,0 +1,45 @@
+package viz
+
+import (
+	"testing"
+
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestKCoreViz(t *testing.T) {
+	g, _, err := ds.Parse(ut.UUGSimple)
+
+	ut.Nil(t, err)
+
+	cores, _, err := algo.KCore(g)
+
+	ut.Nil(t, err)
+
+	vi := NewKCoreViz(g, cores, nil)
+
+	ut.Equal(t, 2, vi.Degeneracy)
+
+	vCount := 0
+	eCount := 0
+
+	vi.OnCoreVertex = func(v int, k int) {
+		ut.Equal(t, cores[v], k)
+		vCount++
+	}
+
+	vi.OnCoreEdge = func(v int, e int, k int) {
+		ut.True(t, k <= cores[v])
+		ut.True(t, k <= cores[g.V[v].E[e].Dst])
+		eCount++
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, g.VertexCount(), vCount)
+	// every undirected edge is visited from both of its vertices
+	ut.Equal(t, 2*g.EdgeCount(), eCount)
+}
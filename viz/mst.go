@@ -20,12 +20,12 @@ type MSTViz struct {
 }
 
 // NewMSTViz initializes a new MSTViz with NOOP hooks.
-func NewMSTViz(g *ds.G, mst algo.MST, t Theme) *MSTViz {
+func NewMSTViz(g VizGraph, mst algo.MST, t Theme) *MSTViz {
 	res := &MSTViz{}
 
 	res.MST = mst
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnMSTEdge = func(int, int) {}
@@ -38,10 +38,7 @@ func (vi *MSTViz) Traverse() error {
 	for _, e := range vi.MST {
 		vi.OnMSTEdge(e.Src, e.Index)
 
-		iV, iE, ok := vi.Graph.EdgeIndex(
-			vi.Graph.V[e.Dst].Item,
-			vi.Graph.V[e.Src].Item,
-		)
+		iV, iE, ok := vi.GetView().ReverseEdge(e.Src, e.Index)
 
 		if !ok {
 			return ds.ErrNoRevEdge
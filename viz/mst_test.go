@@ -31,3 +31,65 @@ func TestMSTViz(t *testing.T) {
 
 	ut.Equal(t, 2*(g.VertexCount()-1), eCount)
 }
+
+func TestMSTViz_multigraph(t *testing.T) {
+	g := ds.NewGraphWith(ds.Multigraph())
+
+	a, b := ds.Text("a"), ds.Text("b")
+
+	g.AddVertex(a)
+	g.AddVertex(b)
+
+	g.AddEdge(a, b, 3)
+	g.AddEdge(a, b, 1)
+
+	mst, err := algo.MSTPrim(g)
+
+	ut.Nil(t, err)
+
+	vi := NewMSTViz(g, mst, nil)
+
+	vi.OnMSTEdge = func(v int, e int) {
+		ut.Equal(t, 1.0, g.V[v].E[e].Wt)
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+}
+
+func TestMSTViz_matrix(t *testing.T) {
+	g, _, err := ds.Parse(ut.WUGSimple)
+
+	ut.Nil(t, err)
+
+	m := toMatrix(t, g)
+
+	mst, err := algo.MSTKruskal(m)
+
+	ut.Nil(t, err)
+
+	vi := NewMSTViz(m, mst, nil)
+
+	wt := 0.0
+
+	vi.OnMSTEdge = func(v int, e int) {
+		edge, ok := m.Edge(v, e)
+
+		ut.True(t, ok)
+
+		wt += edge.Wt
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	expected := 0.0
+
+	for _, e := range mst {
+		expected += 2 * e.Wt
+	}
+
+	ut.Equal(t, expected, wt)
+}
@@ -27,12 +27,12 @@ type SCCViz struct {
 }
 
 // NewSCCViz initializes a new SCCViz with NOOP hooks.
-func NewSCCViz(g *ds.G, sccs []algo.SCC, t Theme) *SCCViz {
+func NewSCCViz(g VizGraph, sccs []algo.SCC, t Theme) *SCCViz {
 	res := &SCCViz{}
 
 	res.SCCs = sccs
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnSCCVertex = func(int, int) {}
@@ -44,7 +44,8 @@ func NewSCCViz(g *ds.G, sccs []algo.SCC, t Theme) *SCCViz {
 
 // Traverse iterates over the results of any SCC algorithm, calling its hooks when appropriate.
 func (vi *SCCViz) Traverse() error {
-	sets := make([]int, vi.Graph.VertexCount())
+	g := vi.GetView()
+	sets := make([]int, g.VertexCount())
 
 	for i := range vi.SCCs {
 		for _, v := range vi.SCCs[i] {
@@ -53,17 +54,17 @@ func (vi *SCCViz) Traverse() error {
 		}
 	}
 
-	for v := range vi.Graph.V {
-		for e := range vi.Graph.V[v].E {
-			cSrc := sets[vi.Graph.V[v].E[e].Src]
-			cDst := sets[vi.Graph.V[v].E[e].Dst]
+	for v := 0; v < g.VertexCount(); v++ {
+		g.ForEachEdge(v, func(e ds.GE) {
+			cSrc := sets[e.Src]
+			cDst := sets[e.Dst]
 
 			if cSrc == cDst {
-				vi.OnSCCEdge(v, e, cSrc)
+				vi.OnSCCEdge(v, e.Index, cSrc)
 			} else {
-				vi.OnCrossSCCEdge(v, e, cSrc, cDst)
+				vi.OnCrossSCCEdge(v, e.Index, cSrc, cDst)
 			}
-		}
+		})
 	}
 
 	return nil
@@ -43,3 +43,33 @@ func TestSCCViz(t *testing.T) {
 	ut.Equal(t, 8, sECount)
 	ut.Equal(t, 6, cECount)
 }
+
+func TestSCCViz_matrix(t *testing.T) {
+	g, _, err := ds.Parse(ut.UDGDeps)
+
+	ut.Nil(t, err)
+
+	sccs, err := algo.SCCTarjan(g)
+
+	ut.Nil(t, err)
+
+	vi := NewSCCViz(toMatrix(t, g), sccs, nil)
+
+	sECount := 0
+	cECount := 0
+
+	vi.OnSCCEdge = func(int, int, int) {
+		sECount++
+	}
+
+	vi.OnCrossSCCEdge = func(int, int, int, int) {
+		cECount++
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 8, sECount)
+	ut.Equal(t, 6, cECount)
+}
@@ -6,20 +6,61 @@ import (
 	"github.com/vc-souza/gga/ds"
 )
 
+/*
+A VizGraph is a graph that can be visualized: besides being read and visited, its edges can be found
+by their Items, undirected edges can be matched to their reverse copies, and the formatting attributes
+of its vertices and edges can be changed in place. Both ds.G and ds.M are VizGraph implementations.
+*/
+type VizGraph interface {
+	ds.Graph
+	ds.Visitable
+
+	// EdgeIndex retrieves the index(es) of the edge associated with the given Items.
+	EdgeIndex(src ds.Item, dst ds.Item) (int, int, bool)
+
+	// ReverseEdge retrieves the index(es) of the reverse copy of an edge, in an undirected graph.
+	ReverseEdge(v, e int) (int, int, bool)
+
+	// VertexFmt retrieves the formatting attributes of a vertex.
+	VertexFmt(v int) *ds.Formattable
+
+	// EdgeFmt retrieves the formatting attributes of an edge, given its source and its index.
+	EdgeFmt(v, e int) *ds.Formattable
+}
+
 /*
 ThemedGraphViz contains data that is useful for graph algorithm visualizations.
 When embedded, it also provides a good part of the AlgoViz interface for free.
+
+View is the graph being visualized, which can be any VizGraph. When that graph
+is a *ds.G, it is also kept in Graph, so hooks can keep changing it directly.
 */
 type ThemedGraphViz struct {
 	Graph *ds.G
+	View  VizGraph
 	Extra []string
 	Theme Theme
 }
 
+// setGraph sets the graph being visualized.
+func (v *ThemedGraphViz) setGraph(g VizGraph) {
+	v.View = g
+	v.Graph, _ = g.(*ds.G)
+}
+
 func (v *ThemedGraphViz) GetGraph() *ds.G {
 	return v.Graph
 }
 
+// GetView retrieves the graph being visualized, falling back to Graph when View is not set.
+func (v *ThemedGraphViz) GetView() VizGraph {
+	if v.View == nil && v.Graph != nil {
+		return v.Graph
+	}
+
+	return v.View
+}
+
 func (v *ThemedGraphViz) GetExtra() []string {
 	return v.Extra
 }
@@ -31,6 +72,9 @@ func (v *ThemedGraphViz) GetTheme() Theme {
 /*
 An AlgoViz implementer can traverse the results of a graph algorithm,
 provide its input graph, and also support theming.
+
+Implementations that visualize graphs other than *ds.G also
+implement GraphViewer (see ThemedGraphViz), and return nil from GetGraph.
 */
 type AlgoViz interface {
 	GetGraph() *ds.G
@@ -39,11 +83,32 @@ type AlgoViz interface {
 	Traverse() error
 }
 
-// ExportViz guides the execution of an AlgoViz implementation and then export its results.
+// A GraphViewer provides the graph being visualized by an AlgoViz, which does not need to be a *ds.G.
+type GraphViewer interface {
+	GetView() VizGraph
+}
+
+// viewOf retrieves the graph being visualized by an AlgoViz.
+func viewOf(vi AlgoViz) VizGraph {
+	if gv, ok := vi.(GraphViewer); ok {
+		if g := gv.GetView(); g != nil {
+			return g
+		}
+	}
+
+	return vi.GetGraph()
+}
+
+/*
+ExportViz guides the execution of an AlgoViz implementation and then export its results.
+The formatting of the graph of the AlgoViz is reset and then changed in place, so a graph
+that needs to keep its own formatting should be visualized through a copy (see ds.G.Clone).
+*/
 func ExportViz(vi AlgoViz, w io.Writer) error {
 	ex := NewExporter()
+	g := viewOf(vi)
 
-	ResetGraphFmt(vi.GetGraph())
+	ResetGraphFmt(g)
 	SetTheme(ex, vi.GetTheme())
 
 	if err := vi.Traverse(); err != nil {
@@ -54,7 +119,7 @@ func ExportViz(vi AlgoViz, w io.Writer) error {
 		ex.AddExtra(vi.GetExtra()...)
 	}
 
-	ex.Export(vi.GetGraph(), w)
+	ex.Export(g, w)
 
 	return nil
 }
@@ -0,0 +1,92 @@
+package viz
+
+import (
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+)
+
+/*
+TourViz formats and exports a graph after the execution of any algorithm that finds
+a tour of its vertices, like the Hamiltonian cycle and Traveling Salesman Problem
+algorithms. The output of the algorithm is traversed, and hooks are provided so
+that custom formatting can be applied to the graph vertices and edges.
+*/
+type TourViz struct {
+	ThemedGraphViz
+
+	Tour algo.Tour
+
+	// OnTourVertex is called for every vertex in the tour, along with its position in the tour.
+	OnTourVertex func(int, int)
+
+	// OnTourEdge is called for every edge that is a part of the tour.
+	OnTourEdge func(int, int)
+}
+
+// NewTourViz initializes a new TourViz with NOOP hooks.
+func NewTourViz(g VizGraph, tour algo.Tour, t Theme) *TourViz {
+	res := &TourViz{}
+
+	res.Tour = tour
+
+	res.setGraph(g)
+	res.Theme = t
+
+	res.OnTourVertex = func(int, int) {}
+	res.OnTourEdge = func(int, int) {}
+
+	return res
+}
+
+// lightestEdge finds the index of the edge of smallest weight from src to dst, if any.
+func lightestEdge(g VizGraph, src, dst int) (int, bool) {
+	idx := -1
+	wt := 0.0
+
+	g.ForEachEdge(src, func(e ds.GE) {
+		if e.Dst != dst {
+			return
+		}
+
+		if idx == -1 || e.Wt < wt {
+			idx, wt = e.Index, e.Wt
+		}
+	})
+
+	return idx, idx != -1
+}
+
+// Traverse iterates over the results of any tour algorithm, calling its hooks when appropriate.
+func (vi *TourViz) Traverse() error {
+	g := vi.GetView()
+
+	for pos, v := range vi.Tour {
+		vi.OnTourVertex(v, pos)
+	}
+
+	for pos, v := range vi.Tour {
+		u := vi.Tour[(pos+1)%len(vi.Tour)]
+
+		iE, ok := lightestEdge(g, v, u)
+
+		if !ok {
+			return ds.ErrNoEdge
+		}
+
+		vi.OnTourEdge(v, iE)
+
+		if g.Directed() || u == v {
+			continue
+		}
+
+		iE, ok = lightestEdge(g, u, v)
+
+		if !ok {
+			return ds.ErrNoRevEdge
+		}
+
+		vi.OnTourEdge(u, iE)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package viz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vc-souza/gga/algo"
+	"github.com/vc-souza/gga/ds"
+	ut "github.com/vc-souza/gga/internal/testutils"
+)
+
+func TestTourViz(t *testing.T) {
+	g, _, err := ds.Parse(ut.WUGSimple)
+
+	ut.Nil(t, err)
+
+	tour, _, err := algo.TSPHeldKarp(g)
+
+	ut.Nil(t, err)
+
+	vi := NewTourViz(g, tour, nil)
+
+	pos := make([]int, g.VertexCount())
+	eCount := 0
+
+	vi.OnTourVertex = func(v int, p int) {
+		pos[v] = p
+	}
+
+	vi.OnTourEdge = func(v int, e int) {
+		eCount++
+
+		d := g.V[v].E[e].Dst
+
+		// tour edges always connect vertices that are next to each other
+		diff := (pos[v] - pos[d] + len(tour)) % len(tour)
+
+		ut.True(t, diff == 1 || diff == len(tour)-1)
+	}
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.Nil(t, err)
+
+	ut.Equal(t, 2*g.VertexCount(), eCount)
+}
+
+func TestTourViz_no_edge(t *testing.T) {
+	g, _, err := ds.Parse(`
+	graph
+	a#b:1
+	b#a:1
+	c#
+	`)
+
+	ut.Nil(t, err)
+
+	vi := NewTourViz(g, algo.Tour{0, 1, 2}, nil)
+
+	err = ExportViz(vi, ut.DummyWriter{})
+
+	ut.True(t, errors.Is(err, ds.ErrNoEdge))
+}
@@ -1,9 +1,5 @@
 package viz
 
-import (
-	"github.com/vc-souza/gga/ds"
-)
-
 /*
 TSortViz formats and exports a directed graph after the execution
 of the Topological Sort algorithm. The output of the algorithm is
@@ -31,12 +27,12 @@ type TSortViz struct {
 }
 
 // NewTSortViz initializes a new TSortViz with NOOP hooks.
-func NewTSortViz(g *ds.G, ord []int, t Theme) *TSortViz {
+func NewTSortViz(g VizGraph, ord []int, t Theme) *TSortViz {
 	res := &TSortViz{}
 
 	res.Order = ord
 
-	res.Graph = g
+	res.setGraph(g)
 	res.Theme = t
 
 	res.OnVertexRank = func(int, int) {}
@@ -47,6 +43,8 @@ func NewTSortViz(g *ds.G, ord []int, t Theme) *TSortViz {
 
 // Traverse iterates over the results of a Topological Sort execution, calling its hooks when appropriate.
 func (vi *TSortViz) Traverse() error {
+	g := vi.GetView()
+
 	var rank int
 	var next int
 	prev := -1
@@ -59,9 +57,9 @@ func (vi *TSortViz) Traverse() error {
 		next = v
 
 		if prev != -1 {
-			_, idx, ok := vi.Graph.EdgeIndex(
-				vi.Graph.V[prev].Item,
-				vi.Graph.V[next].Item,
+			_, idx, ok := g.EdgeIndex(
+				g.Item(prev),
+				g.Item(next),
 			)
 
 			vi.OnOrderEdge(prev, idx, next, ok)